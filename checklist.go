@@ -0,0 +1,131 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/assessment"
+	"github.com/carlosmmatos/automate-compliance/internal/stig"
+)
+
+// cciList is the DISA CCI list given with -cci, empty for the built-in table.
+var cciList string
+
+func registerCCIFlag(fs *flag.FlagSet) {
+	fs.StringVar(&cciList, "cci", "", "DISA CCI list (U_CCI_List.xml) mapping CCIs to NIST 800-53 controls, a built-in subset by default")
+}
+
+func loadCCIs() (stig.CCIs, error) {
+	if cciList == "" {
+		return stig.DefaultCCIs(), nil
+	}
+	return stig.LoadCCIList(cciList)
+}
+
+func loadChecklistResults(path string) ([]assessment.Result, []error, error) {
+	ccis, err := loadCCIs()
+	if err != nil {
+		return nil, nil, err
+	}
+	cl, err := stig.Load(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, warnings := cl.ControlResults(path, ccis)
+	if err := unmappedWarning(cl, ccis); err != nil {
+		warnings = append(warnings, err)
+	}
+	return results, warnings, nil
+}
+
+// unmappedWarning returns a warning giving the number of vulnerabilities
+// whose CCIs aren't all mapped to a control, nil when there are none. The
+// built-in CCIs only cover the most common ones, see stig.DefaultCCIs.
+func unmappedWarning(cl *stig.Checklist, ccis stig.CCIs) error {
+	unmapped, total := cl.Unmapped(ccis)
+	if unmapped == 0 {
+		return nil
+	}
+	hint := "check the CCI list"
+	if cciList == "" {
+		hint = "use -cci with the DISA CCI list"
+	}
+	return fmt.Errorf("%d of %d vulnerabilities reference CCIs that aren't mapped to a NIST 800-53 control, %s", unmapped, total, hint)
+}
+
+func runChecklist(args []string) error {
+	fs := flag.NewFlagSet("checklist", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	registerCCIFlag(fs)
+	component := fs.String("component", "", "controls to take the narratives from: a component.yaml or a sheet spec (\"sheet:<id>\" or a CSV file), the sheet given by the source flags by default")
+	out := fs.String("o", "-", "file to write the checklist to, - for stdout")
+	host := fs.String("host", "", "host name of the reviewed asset")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: autocmp checklist [flags] <STIG xccdf.xml, .ckl or .cklb>\n\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	ccis, err := loadCCIs()
+	if err != nil {
+		return err
+	}
+	cl, err := loadChecklistTemplate(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	var c *v3c.Component
+	if *component == "" {
+		c, err = src.loadComponent()
+	} else {
+		c, err = src.component(*component)
+	}
+	if err != nil {
+		return err
+	}
+	if *host != "" {
+		cl.Asset.HostName = *host
+	}
+	filled := cl.Prefill(c, ccis)
+	if err := unmappedWarning(cl, ccis); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	w, err := createOutput(*out)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	if err := cl.WriteCKL(w); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	total := 0
+	for _, s := range cl.STIGs {
+		total += len(s.Vulns)
+	}
+	fmt.Fprintf(os.Stderr, "Pre-filled the finding details of %d of %d vulnerabilities\n", filled, total)
+	return nil
+}
+
+// loadChecklistTemplate reads the checklist to fill in: a STIG as published
+// by DISA in the XCCDF format, or an existing checklist.
+func loadChecklistTemplate(path string) (*stig.Checklist, error) {
+	if strings.ToLower(filepath.Ext(path)) == ".xml" {
+		return stig.LoadBenchmark(path)
+	}
+	return stig.Load(path)
+}
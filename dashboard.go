@@ -0,0 +1,35 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/carlosmmatos/automate-compliance/internal/dashboard"
+)
+
+func runDashboard(args []string) error {
+	fs := flag.NewFlagSet("dashboard", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	out := fs.String("o", "dashboard.html", "HTML file to write")
+	title := fs.String("title", "Compliance dashboard", "title of the dashboard")
+	fs.Parse(args)
+
+	res, err := src.loadResult()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := dashboard.NewPage(*title, res, time.Now()).Write(f); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote %s\n", *out)
+	return f.Close()
+}
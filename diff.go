@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/carlosmmatos/automate-compliance/internal/diff"
+)
+
+func runDiff(args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	format := fs.String("format", "text", "output format: text or json")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: autocmp diff [flags] <old> <new>\n\n"+
+			"<old> and <new> are either \"sheet\", \"sheet:<spreadsheet id>\", a CSV export\n"+
+			"of a spreadsheet revision or an OpenControl component.yaml.\n\nFlags:\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	old, err := src.component(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("%s: %v", fs.Arg(0), err)
+	}
+	new, err := src.component(fs.Arg(1))
+	if err != nil {
+		return fmt.Errorf("%s: %v", fs.Arg(1), err)
+	}
+
+	report := diff.NewReport(fs.Arg(0), fs.Arg(1), old.Satisfies, new.Satisfies)
+	switch *format {
+	case "text":
+		return report.WriteText(os.Stdout)
+	case "json":
+		return report.WriteJSON(os.Stdout)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/carlosmmatos/automate-compliance/internal/docs"
+)
+
+func runDocs(args []string) error {
+	fs := flag.NewFlagSet("docs", flag.ExitOnError)
+	var comps componentFlags
+	comps.registerSheets(fs)
+	out := fs.String("o", "docs", "directory to write the Markdown tree to")
+	fs.Parse(args)
+
+	results, err := comps.results()
+	if err != nil {
+		return err
+	}
+	var components []docs.Component
+	for _, r := range results {
+		components = append(components, docs.Component{Name: r.name, Data: r.res.Data})
+	}
+
+	if err := docs.Write(*out, components); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote %s\n", *out)
+	return nil
+}
@@ -3,13 +3,15 @@ module github.com/carlosmmatos/automate-compliance
 go 1.15
 
 require (
-	github.com/blang/semver v3.5.1+incompatible // indirect
+	github.com/blang/semver v3.5.1+incompatible
 	github.com/fatih/set v0.2.1 // indirect
 	github.com/opencontrol/compliance-masonry v1.1.6
+	github.com/santhosh-tekuri/jsonschema/v5 v5.0.0
 	golang.org/x/net v0.0.0-20210503060351-7fd8e65b6420
 	golang.org/x/oauth2 v0.0.0-20210628180205-a41e5a781914
 	google.golang.org/api v0.50.0
-	vbom.ml/util/sortorder v0.0.0-00010101000000-000000000000 // indirect
+	gopkg.in/yaml.v2 v2.4.0
+	vbom.ml/util/sortorder v0.0.0-00010101000000-000000000000
 )
 
 replace vbom.ml/util/sortorder => github.com/fvbommel/sortorder v1.0.1
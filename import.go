@@ -0,0 +1,218 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/carlosmmatos/automate-compliance/internal/assessment"
+	"github.com/carlosmmatos/automate-compliance/internal/opencontrol"
+	"github.com/carlosmmatos/automate-compliance/internal/operator"
+	"github.com/carlosmmatos/automate-compliance/internal/oscal"
+	"github.com/carlosmmatos/automate-compliance/internal/rhacm"
+	"github.com/carlosmmatos/automate-compliance/internal/xccdf"
+)
+
+// resultLoader reads the results of an assessment from a file. Problems that
+// don't prevent the file from being read are returned as warnings. Loaders
+// needing flags of their own register them with flags.
+type resultLoader struct {
+	summary string
+	load    func(path string) (results []assessment.Result, warnings []error, err error)
+	flags   func(fs *flag.FlagSet)
+}
+
+var resultLoaders = map[string]resultLoader{
+	"ckl":                 {"STIG Viewer checklists (.ckl or .cklb)", loadChecklistResults, registerCCIFlag},
+	"compliance-operator": {"ComplianceCheckResult and ComplianceScan objects of the OpenShift Compliance Operator", loadOperatorResults, nil},
+	"oscal":               {"OSCAL assessment-results from an assessor", loadOSCALResults, nil},
+	"rhacm":               {"RHACM policies from a GitOps repository or exported with their compliance status", loadPolicyResults, nil},
+	"xccdf":               {"XCCDF results or ARF reports from OpenSCAP", loadXCCDFResults, nil},
+}
+
+// registerLoaderFlags registers the flags of every loader, so the commands
+// taking a format as flag rather than argument accept them all.
+func registerLoaderFlags(fs *flag.FlagSet) {
+	for _, name := range resultFormats() {
+		if l := resultLoaders[name]; l.flags != nil {
+			l.flags(fs)
+		}
+	}
+}
+
+func loadOSCALResults(path string) ([]assessment.Result, []error, error) {
+	ar, err := oscal.LoadAssessmentResults(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, warnings := ar.ControlResults(path)
+	return results, warnings, nil
+}
+
+func loadOperatorResults(path string) ([]assessment.Result, []error, error) {
+	res, err := operator.Load(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, warnings := res.ControlResults(path)
+	return results, warnings, nil
+}
+
+func loadPolicyResults(path string) ([]assessment.Result, []error, error) {
+	set := rhacm.NewSet()
+	if err := set.Load(path); err != nil {
+		return nil, nil, err
+	}
+	results, warnings := set.ControlResults()
+	return results, warnings, nil
+}
+
+func loadXCCDFResults(path string) ([]assessment.Result, []error, error) {
+	report, err := xccdf.Load(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, warnings := report.ControlResults(path)
+	return results, warnings, nil
+}
+
+// loadResults reads the results of every file with the loader of a format,
+// printing the warnings.
+func loadResults(format string, paths []string) ([]assessment.Result, error) {
+	loader, ok := resultLoaders[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown format %q, expected one of %s", format, strings.Join(resultFormats(), ", "))
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("expected at least one file to read")
+	}
+
+	var results []assessment.Result
+	for _, path := range paths {
+		r, warnings, err := loader.load(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range warnings {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", w)
+		}
+		results = append(results, r...)
+	}
+	return results, nil
+}
+
+func resultFormats() []string {
+	var names []string
+	for name := range resultLoaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func runImport(args []string) error {
+	if len(args) == 0 {
+		importUsage()
+		os.Exit(2)
+	}
+	format := args[0]
+	if _, ok := resultLoaders[format]; !ok {
+		importUsage()
+		os.Exit(2)
+	}
+
+	fs := flag.NewFlagSet("import "+format, flag.ExitOnError)
+	var f importFlags
+	f.register(fs)
+	if l := resultLoaders[format]; l.flags != nil {
+		l.flags(fs)
+	}
+	fs.Parse(args[1:])
+	if err := f.validate(); err != nil {
+		return err
+	}
+
+	results, err := loadResults(format, fs.Args())
+	if err != nil {
+		return err
+	}
+	return f.apply(results)
+}
+
+func importUsage() {
+	fmt.Fprintf(os.Stderr, "Usage: autocmp import <format> [flags] <file>...\n\nFormats:\n")
+	for _, name := range resultFormats() {
+		fmt.Fprintf(os.Stderr, "  %-20s %s\n", name, resultLoaders[name].summary)
+	}
+}
+
+// importFlags holds the flags of the import command, which applies the
+// results of an assessment to a component.
+type importFlags struct {
+	src       sheetSource
+	component string
+	out       string
+	format    string
+}
+
+func (f *importFlags) register(fs *flag.FlagSet) {
+	f.src.register(fs)
+	fs.StringVar(&f.component, "component", "component.yaml", "component to update: a component.yaml or a sheet spec (\"sheet\", \"sheet:<id>\" or a CSV file)")
+	fs.StringVar(&f.out, "o", "", "component.yaml to write, the -component file by default")
+	fs.StringVar(&f.format, "format", "text", "format of the report: text or json")
+}
+
+// validate checks the flags, before anything is loaded or written.
+func (f *importFlags) validate() error {
+	if f.format != "text" && f.format != "json" {
+		return fmt.Errorf("unknown format %q", f.format)
+	}
+	if ext := strings.ToLower(filepath.Ext(f.component)); f.out == "" && ext != ".yaml" && ext != ".yml" {
+		return fmt.Errorf("-o is required when the component is read from a sheet")
+	}
+	return nil
+}
+
+// apply applies the results to the component and writes it back, then
+// prints what changed. The flags must have been validated.
+func (f *importFlags) apply(results []assessment.Result) error {
+	out := f.out
+	if out == "" {
+		out = f.component
+	}
+
+	c, owners, err := f.src.componentOwners(f.component)
+	if err != nil {
+		return err
+	}
+	report := assessment.Apply(c, results)
+	if err := opencontrol.WriteComponent(out, c, owners); err != nil {
+		return err
+	}
+
+	if f.format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+	return writeImportReport(os.Stdout, out, report)
+}
+
+func writeImportReport(w io.Writer, path string, r assessment.Report) error {
+	fmt.Fprintf(w, "Updated %d controls in %s\n", len(r.Updated), path)
+	for _, k := range r.Updated {
+		fmt.Fprintf(w, "  %s\n", k)
+	}
+	if len(r.Missing) > 0 {
+		fmt.Fprintf(w, "\n%d assessed controls are not in the component:\n", len(r.Missing))
+		for _, k := range r.Missing {
+			fmt.Fprintf(w, "  %s\n", k)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+// Package api serves the parsed assessment over HTTP: parsing posted rows,
+// and the components, diagnostics and reports of the products synced by
+// `autocmp serve`. The API is described by openapiSpec.
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/baseline"
+	"github.com/carlosmmatos/automate-compliance/internal/catalog"
+	"github.com/carlosmmatos/automate-compliance/internal/opencontrol"
+	"github.com/carlosmmatos/automate-compliance/internal/oscal"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+	"github.com/carlosmmatos/automate-compliance/internal/report"
+	"github.com/carlosmmatos/automate-compliance/internal/source"
+)
+
+// Prefix is the path the API is served under.
+const Prefix = "/api/v1/"
+
+// standard is the standard key of the controls of the components.
+const standard = "NIST-800-53"
+
+// maxBodySize limits the size of the posted rows.
+const maxBodySize = 10 << 20
+
+// Product is the last sync of a product.
+type Product struct {
+	Name string
+	// Result is the last successful parse of the rows, nil before the first
+	Result *source.Result
+	// Synced is the time of the last successful sync
+	Synced time.Time
+	// Err is the error of the last sync, nil when it succeeded
+	Err error
+}
+
+// Store gives the products served.
+type Store interface {
+	Products() []Product
+}
+
+// Handler serves the API.
+type Handler struct {
+	store Store
+}
+
+// NewHandler returns the handler of the API, to be mounted on Prefix.
+func NewHandler(store Store) *Handler {
+	return &Handler{store: store}
+}
+
+// apiError is the body of the error responses.
+type apiError struct {
+	Error string `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, format string, args ...interface{}) {
+	writeJSON(w, status, apiError{Error: fmt.Sprintf(format, args...)})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(v)
+}
+
+// jsonWriter is implemented by the reports and OSCAL documents.
+type jsonWriter interface {
+	WriteJSON(w io.Writer) error
+}
+
+func writeDocument(w http.ResponseWriter, doc jsonWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	doc.WriteJSON(w)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, Prefix), "/"), "/")
+	if len(path) == 1 && path[0] == "parse" {
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w, r, http.MethodPost)
+			return
+		}
+		h.parse(w, r)
+		return
+	}
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w, r, http.MethodGet)
+		return
+	}
+
+	switch {
+	case len(path) == 1 && path[0] == "openapi.json":
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, openapiSpec)
+	case len(path) == 1 && path[0] == "products":
+		h.products(w)
+	case len(path) == 3 && path[0] == "products" && path[2] == "component":
+		if p, ok := h.product(w, path[1]); ok {
+			h.component(w, r, p)
+		}
+	case len(path) == 3 && path[0] == "products" && path[2] == "diagnostics":
+		if p, ok := h.product(w, path[1]); ok {
+			writeJSON(w, http.StatusOK, nonNil(p.Result.Diagnostics))
+		}
+	case len(path) == 4 && path[0] == "products" && path[2] == "reports":
+		if p, ok := h.product(w, path[1]); ok {
+			h.report(w, r, p, path[3])
+		}
+	default:
+		writeError(w, http.StatusNotFound, "unknown path %s", r.URL.Path)
+	}
+}
+
+func methodNotAllowed(w http.ResponseWriter, r *http.Request, allowed string) {
+	w.Header().Set("Allow", allowed)
+	writeError(w, http.StatusMethodNotAllowed, "%s %s isn't supported, use %s", r.Method, r.URL.Path, allowed)
+}
+
+func nonNil(d []parser.Diagnostic) []parser.Diagnostic {
+	if d == nil {
+		return []parser.Diagnostic{}
+	}
+	return d
+}
+
+// ParseResult is the response of POST /parse.
+type ParseResult struct {
+	Component   *v3c.Component            `json:"component"`
+	Details     map[string]parser.Details `json:"details"`
+	Diagnostics []parser.Diagnostic       `json:"diagnostics"`
+}
+
+// parse parses the posted rows, either a CSV export whose layout is given by
+// the columns parameter or a JSON array of rows.
+func (h *Handler) parse(w http.ResponseWriter, r *http.Request) {
+	body := http.MaxBytesReader(w, r.Body, maxBodySize)
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	var rows []parser.Row
+	switch mediaType {
+	case "text/csv":
+		cols, err := source.ParseColumns(r.URL.Query().Get("columns"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "columns: %v", err)
+			return
+		}
+		if rows, err = source.ReadCSV(body, cols); err != nil {
+			writeError(w, http.StatusBadRequest, "reading the CSV: %v", err)
+			return
+		}
+	case "application/json":
+		if err := json.NewDecoder(body).Decode(&rows); err != nil {
+			writeError(w, http.StatusBadRequest, "reading the rows: %v", err)
+			return
+		}
+		for i := range rows {
+			if rows[i].Line == 0 {
+				rows[i].Line = source.FirstDataLine + i
+			}
+		}
+	default:
+		writeError(w, http.StatusUnsupportedMediaType, "expected text/csv or application/json, got %q", r.Header.Get("Content-Type"))
+		return
+	}
+	if len(rows) == 0 {
+		writeError(w, http.StatusBadRequest, "no rows")
+		return
+	}
+
+	res := source.ParseRows(rows)
+	c := opencontrol.NewComponent(r.URL.Query().Get("name"), "", standard, res.Data)
+	c.ResponsibleRole = opencontrol.NewOwners(res.Details).ResponsibleRole()
+	details := res.Details
+	if details == nil {
+		details = map[string]parser.Details{}
+	}
+	writeJSON(w, http.StatusOK, ParseResult{Component: c, Details: details, Diagnostics: nonNil(res.Diagnostics)})
+}
+
+// ProductStatus summarizes the last sync of a product.
+type ProductStatus struct {
+	Name string `json:"name"`
+	// Synced is the time of the last successful sync, nil before the first
+	Synced   *time.Time `json:"synced"`
+	Error    string     `json:"error,omitempty"`
+	Controls int        `json:"controls"`
+	Errors   int        `json:"errors"`
+	Warnings int        `json:"warnings"`
+}
+
+func (h *Handler) products(w http.ResponseWriter) {
+	statuses := []ProductStatus{}
+	for _, p := range h.store.Products() {
+		s := ProductStatus{Name: p.Name}
+		if !p.Synced.IsZero() {
+			synced := p.Synced
+			s.Synced = &synced
+		}
+		if p.Err != nil {
+			s.Error = p.Err.Error()
+		}
+		if p.Result != nil {
+			for _, ctrls := range p.Result.Data {
+				s.Controls += len(ctrls)
+			}
+			for _, d := range p.Result.Diagnostics {
+				if d.Severity == parser.SeverityError {
+					s.Errors++
+				} else {
+					s.Warnings++
+				}
+			}
+		}
+		statuses = append(statuses, s)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	writeJSON(w, http.StatusOK, statuses)
+}
+
+// product finds a product that has been synced, writing the error response
+// otherwise.
+func (h *Handler) product(w http.ResponseWriter, name string) (Product, bool) {
+	for _, p := range h.store.Products() {
+		if p.Name != name {
+			continue
+		}
+		if p.Result == nil {
+			msg := "not synced yet"
+			if p.Err != nil {
+				msg = p.Err.Error()
+			}
+			writeError(w, http.StatusServiceUnavailable, "product %s: %s", name, msg)
+			return p, false
+		}
+		return p, true
+	}
+	writeError(w, http.StatusNotFound, "unknown product %q", name)
+	return Product{}, false
+}
+
+// component writes the component of a product as OpenControl JSON or YAML, or
+// as an OSCAL component-definition.
+func (h *Handler) component(w http.ResponseWriter, r *http.Request, p Product) {
+	c := opencontrol.NewComponent(p.Name, "", standard, p.Result.Data)
+	owners := opencontrol.NewOwners(p.Result.Details)
+	c.ResponsibleRole = owners.ResponsibleRole()
+	switch format := r.URL.Query().Get("format"); format {
+	case "", "json":
+		writeJSON(w, http.StatusOK, c)
+	case "yaml":
+		b, err := opencontrol.MarshalComponent(c, owners)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "%v", err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Write(b)
+	case "oscal":
+		writeDocument(w, oscal.NewComponentDefinition(p.Name, oscal.DefaultCatalog, []*v3c.Component{c}, p.Synced))
+	default:
+		writeError(w, http.StatusBadRequest, "unknown format %q, expected json, yaml or oscal", format)
+	}
+}
+
+// Reports are the reports served.
+var Reports = []string{"coverage", "gaps", "overdue", "parts"}
+
+// report writes a report about a product as JSON. The reports take the
+// parameters of their command: baseline for gaps, grace and on for overdue.
+func (h *Handler) report(w http.ResponseWriter, r *http.Request, p Product, name string) {
+	q := r.URL.Query()
+	switch name {
+	case "coverage":
+		writeDocument(w, report.NewCoverage(p.Result.Data))
+	case "gaps":
+		b, err := baseline.Get(orDefault(q.Get("baseline"), "nist-moderate"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "%v", err)
+			return
+		}
+		writeDocument(w, report.NewGaps(b, p.Result.Data))
+	case "overdue":
+		grace, err := strconv.Atoi(orDefault(q.Get("grace"), "0"))
+		if err != nil || grace < 0 {
+			writeError(w, http.StatusBadRequest, "invalid grace %q", q.Get("grace"))
+			return
+		}
+		now := time.Now()
+		if on := q.Get("on"); on != "" {
+			if now, err = time.Parse(parser.DateFormat, on); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid date %q, expected YYYY-MM-DD", on)
+				return
+			}
+		}
+		writeDocument(w, report.NewOverdue(p.Result.Data, p.Result.Details, now, report.Grace{Days: grace}))
+	case "parts":
+		writeDocument(w, report.NewParts(p.Result.Data, catalog.Default()))
+	default:
+		writeError(w, http.StatusNotFound, "unknown report %q, expected one of %s", name, strings.Join(Reports, ", "))
+	}
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,196 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+	"github.com/carlosmmatos/automate-compliance/internal/source"
+)
+
+type fakeStore []Product
+
+func (s fakeStore) Products() []Product {
+	return s
+}
+
+const csvRows = `Family,Control,Narrative,Status,Owner,Due
+ACCESS CONTROL,AC-2,Accounts are reviewed quarterly.,Complete,alice,
+ACCESS CONTROL,AC-3,Access is enforced by RBAC.,Planned,bob,2026-09-01
+ACCESS CONTROL,nonsense,,,,
+`
+
+func testServer(t *testing.T) *httptest.Server {
+	cols, err := source.ParseColumns("family=A,control=B,narrative=C,status=D,owner=E,due=F")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := source.ReadCSV(strings.NewReader(csvRows), cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := source.ParseRows(rows)
+	synced := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	store := fakeStore{
+		{Name: "acm", Result: &res, Synced: synced},
+		{Name: "ocp", Err: errors.New("no data found")},
+	}
+	return httptest.NewServer(NewHandler(store))
+}
+
+func do(t *testing.T, srv *httptest.Server, method, path, contentType, body string) (int, string, string) {
+	req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, resp.Header.Get("Content-Type"), string(b)
+}
+
+func TestHandler(t *testing.T) {
+	srv := testServer(t)
+	defer srv.Close()
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		status      int
+		contentType string
+		contains    string
+	}{
+		{"products", "GET", "/api/v1/products", 200, "application/json", `"name": "ocp",
+    "synced": null,
+    "error": "no data found"`},
+		{"component json", "GET", "/api/v1/products/acm/component", 200, "application/json", `"control_key": "AC-3"`},
+		{"component yaml", "GET", "/api/v1/products/acm/component?format=yaml", 200, "application/yaml", "responsible_role: alice"},
+		{"component oscal", "GET", "/api/v1/products/acm/component?format=oscal", 200, "application/json", `"control-id": "ac-3"`},
+		{"unknown format", "GET", "/api/v1/products/acm/component?format=xml", 400, "application/json", `unknown format \"xml\"`},
+		{"diagnostics", "GET", "/api/v1/products/acm/diagnostics", 200, "application/json", `"control": "nonsense"`},
+		{"coverage", "GET", "/api/v1/products/acm/reports/coverage", 200, "application/json", `"families"`},
+		{"gaps", "GET", "/api/v1/products/acm/reports/gaps?baseline=nist-low", 200, "application/json", `"baseline": "NIST SP 800-53B Low"`},
+		{"unknown baseline", "GET", "/api/v1/products/acm/reports/gaps?baseline=nope", 400, "application/json", `"error"`},
+		{"overdue", "GET", "/api/v1/products/acm/reports/overdue?on=2026-10-01&grace=10", 200, "application/json", `"days_late": 30`},
+		{"invalid date", "GET", "/api/v1/products/acm/reports/overdue?on=tomorrow", 400, "application/json", "invalid date"},
+		{"parts", "GET", "/api/v1/products/acm/reports/parts", 200, "application/json", `"flagged"`},
+		{"unknown report", "GET", "/api/v1/products/acm/reports/trend", 404, "application/json", "unknown report"},
+		{"not synced", "GET", "/api/v1/products/ocp/component", 503, "application/json", "product ocp: no data found"},
+		{"unknown product", "GET", "/api/v1/products/rhel/component", 404, "application/json", `unknown product \"rhel\"`},
+		{"unknown path", "GET", "/api/v1/nope", 404, "application/json", "unknown path"},
+		{"wrong method", "DELETE", "/api/v1/products", 405, "application/json", "use GET"},
+		{"get parse", "GET", "/api/v1/parse", 405, "application/json", "use POST"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, contentType, body := do(t, srv, tt.method, tt.path, "", "")
+			if status != tt.status || contentType != tt.contentType || !strings.Contains(body, tt.contains) {
+				t.Errorf("%s %s = %d %s %s, want %d %s containing %q", tt.method, tt.path, status, contentType, body, tt.status, tt.contentType, tt.contains)
+			}
+		})
+	}
+}
+
+func TestHandler_Parse(t *testing.T) {
+	srv := testServer(t)
+	defer srv.Close()
+
+	jsonRows := `[
+  {"family": "ACCESS CONTROL", "control": "AC-2", "narrative": "Reviewed.", "status": "Complete", "owner": "alice"},
+  {"family": "ACCESS CONTROL", "control": "nonsense"}
+]`
+	for _, tt := range []struct {
+		name, path, contentType, body string
+	}{
+		{"csv", "/api/v1/parse?columns=family=A,control=B,narrative=C,status=D,owner=E&name=acm", "text/csv; charset=utf-8", csvRows},
+		{"json", "/api/v1/parse?name=acm", "application/json", jsonRows},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			status, _, body := do(t, srv, "POST", tt.path, tt.contentType, tt.body)
+			if status != http.StatusOK {
+				t.Fatalf("POST %s = %d %s", tt.path, status, body)
+			}
+			var got ParseResult
+			if err := json.Unmarshal([]byte(body), &got); err != nil {
+				t.Fatalf("decoding %s: %v", body, err)
+			}
+			if got.Component.Name != "acm" || got.Component.ResponsibleRole != "alice" || got.Component.Satisfies[0].ControlKey != "AC-2" {
+				t.Errorf("component = %+v", got.Component)
+			}
+			if got.Details["AC-2"].Owner != "alice" {
+				t.Errorf("details = %+v", got.Details)
+			}
+			if len(got.Diagnostics) != 1 || got.Diagnostics[0].Control != "nonsense" || got.Diagnostics[0].Severity != parser.SeverityError {
+				t.Errorf("diagnostics = %+v, want the nonsense row", got.Diagnostics)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		name, contentType, body string
+		status                  int
+	}{
+		{"xml", "application/xml", "<rows/>", http.StatusUnsupportedMediaType},
+		{"invalid json", "application/json", "{", http.StatusBadRequest},
+		{"no rows", "application/json", "[]", http.StatusBadRequest},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if status, _, body := do(t, srv, "POST", "/api/v1/parse", tt.contentType, tt.body); status != tt.status {
+				t.Errorf("POST /parse = %d %s, want %d", status, body, tt.status)
+			}
+		})
+	}
+}
+
+// TestOpenAPI checks the description is valid JSON and documents every
+// route of the handler.
+func TestOpenAPI(t *testing.T) {
+	var spec struct {
+		Paths map[string]map[string]interface{} `json:"paths"`
+	}
+	if err := json.Unmarshal([]byte(openapiSpec), &spec); err != nil {
+		t.Fatalf("openapiSpec isn't valid JSON: %v", err)
+	}
+	var got []string
+	for path, ops := range spec.Paths {
+		for method := range ops {
+			got = append(got, strings.ToUpper(method)+" "+path)
+		}
+	}
+	sort.Strings(got)
+	want := []string{
+		"GET /openapi.json",
+		"GET /products",
+		"GET /products/{product}/component",
+		"GET /products/{product}/diagnostics",
+		"GET /products/{product}/reports/{report}",
+		"POST /parse",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("paths = %q, want %q", got, want)
+	}
+
+	srv := testServer(t)
+	defer srv.Close()
+	if status, _, body := do(t, srv, "GET", "/api/v1/openapi.json", "", ""); status != http.StatusOK || body != openapiSpec {
+		t.Errorf("GET /openapi.json = %d, want the description", status)
+	}
+}
@@ -0,0 +1,189 @@
+package api
+
+// openapiSpec is the OpenAPI description of the API, served on
+// /api/v1/openapi.json.
+const openapiSpec = `{
+  "openapi": "3.0.3",
+  "info": {
+    "title": "autocmp API",
+    "description": "Parses NIST 800-53 assessment spreadsheets and serves the components, diagnostics and reports of the products synced by autocmp serve.",
+    "version": "1.0.0"
+  },
+  "servers": [{"url": "/api/v1"}],
+  "paths": {
+    "/parse": {
+      "post": {
+        "summary": "Parse assessment rows",
+        "operationId": "parse",
+        "parameters": [
+          {"name": "columns", "in": "query", "description": "Column layout of a CSV body, e.g. family=A,control=B,narrative=C,status=D. The first record is the header and is skipped.", "schema": {"type": "string"}},
+          {"name": "name", "in": "query", "description": "Name of the component", "schema": {"type": "string"}}
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "text/csv": {"schema": {"type": "string"}},
+            "application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Row"}}}
+          }
+        },
+        "responses": {
+          "200": {"description": "The parsed rows", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/ParseResult"}}}},
+          "400": {"$ref": "#/components/responses/Error"},
+          "415": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/products": {
+      "get": {
+        "summary": "List the products and their last sync",
+        "operationId": "listProducts",
+        "responses": {
+          "200": {"description": "The products", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/ProductStatus"}}}}}
+        }
+      }
+    },
+    "/products/{product}/component": {
+      "get": {
+        "summary": "Get the component of a product",
+        "operationId": "getComponent",
+        "parameters": [
+          {"$ref": "#/components/parameters/Product"},
+          {"name": "format", "in": "query", "description": "OpenControl JSON or YAML, or OSCAL component-definition", "schema": {"type": "string", "enum": ["json", "yaml", "oscal"], "default": "json"}}
+        ],
+        "responses": {
+          "200": {
+            "description": "The component",
+            "content": {
+              "application/json": {"schema": {"oneOf": [{"$ref": "#/components/schemas/Component"}, {"type": "object", "description": "OSCAL component-definition"}]}},
+              "application/yaml": {"schema": {"type": "string"}}
+            }
+          },
+          "400": {"$ref": "#/components/responses/Error"},
+          "404": {"$ref": "#/components/responses/Error"},
+          "503": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/products/{product}/diagnostics": {
+      "get": {
+        "summary": "Get the rows of a product that couldn't be parsed or have warnings",
+        "operationId": "getDiagnostics",
+        "parameters": [{"$ref": "#/components/parameters/Product"}],
+        "responses": {
+          "200": {"description": "The diagnostics", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Diagnostic"}}}}},
+          "404": {"$ref": "#/components/responses/Error"},
+          "503": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/products/{product}/reports/{report}": {
+      "get": {
+        "summary": "Get a report about a product",
+        "description": "The reports of autocmp report, as JSON.",
+        "operationId": "getReport",
+        "parameters": [
+          {"$ref": "#/components/parameters/Product"},
+          {"name": "report", "in": "path", "required": true, "schema": {"type": "string", "enum": ["coverage", "gaps", "overdue", "parts"]}},
+          {"name": "baseline", "in": "query", "description": "Baseline of the gaps report", "schema": {"type": "string", "default": "nist-moderate"}},
+          {"name": "grace", "in": "query", "description": "Days a control may stay open past its date, for the overdue report", "schema": {"type": "integer", "minimum": 0, "default": 0}},
+          {"name": "on", "in": "query", "description": "Day the overdue report checks the dates against, default today", "schema": {"type": "string", "format": "date"}}
+        ],
+        "responses": {
+          "200": {"description": "The report", "content": {"application/json": {"schema": {"type": "object"}}}},
+          "400": {"$ref": "#/components/responses/Error"},
+          "404": {"$ref": "#/components/responses/Error"},
+          "503": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/openapi.json": {
+      "get": {
+        "summary": "Get this description",
+        "operationId": "getOpenAPI",
+        "responses": {"200": {"description": "The OpenAPI description", "content": {"application/json": {"schema": {"type": "object"}}}}}
+      }
+    }
+  },
+  "components": {
+    "parameters": {
+      "Product": {"name": "product", "in": "path", "required": true, "description": "Name of the product, see -product", "schema": {"type": "string"}}
+    },
+    "responses": {
+      "Error": {"description": "An error", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}}
+    },
+    "schemas": {
+      "Error": {"type": "object", "properties": {"error": {"type": "string"}}, "required": ["error"]},
+      "Row": {
+        "type": "object",
+        "description": "A row of the assessment spreadsheet. Only family and control are required.",
+        "properties": {
+          "line": {"type": "integer", "description": "Row number reported in the diagnostics, default the position in the array"},
+          "family": {"type": "string"},
+          "control": {"type": "string", "example": "AC-2 (1)"},
+          "narrative": {"type": "string"},
+          "status": {"type": "string"},
+          "origin": {"type": "string"},
+          "owner": {"type": "string"},
+          "evidence": {"type": "string"},
+          "milestone": {"type": "string", "format": "date"},
+          "due": {"type": "string", "format": "date"},
+          "automatable": {"type": "string"},
+          "policy": {"type": "string"}
+        },
+        "required": ["family", "control"]
+      },
+      "Diagnostic": {
+        "type": "object",
+        "properties": {
+          "line": {"type": "integer"},
+          "control": {"type": "string"},
+          "severity": {"type": "string", "enum": ["error", "warning"]},
+          "message": {"type": "string"},
+          "owner": {"type": "string", "description": "Owner cell of the row"}
+        }
+      },
+      "Details": {
+        "type": "object",
+        "properties": {
+          "owner": {"type": "string"},
+          "evidence": {"type": "array", "items": {"type": "string"}},
+          "milestone": {"type": "string", "format": "date"},
+          "due": {"type": "string", "format": "date"},
+          "automatable": {"type": "boolean"},
+          "policies": {"type": "array", "items": {"type": "string"}},
+          "lines": {"type": "array", "items": {"type": "integer"}}
+        }
+      },
+      "Component": {
+        "type": "object",
+        "description": "OpenControl component, schema 3.1.0",
+        "properties": {
+          "name": {"type": "string"},
+          "key": {"type": "string"},
+          "responsible_role": {"type": "string"},
+          "satisfies": {"type": "array", "items": {"type": "object"}}
+        }
+      },
+      "ParseResult": {
+        "type": "object",
+        "properties": {
+          "component": {"$ref": "#/components/schemas/Component"},
+          "details": {"type": "object", "additionalProperties": {"$ref": "#/components/schemas/Details"}},
+          "diagnostics": {"type": "array", "items": {"$ref": "#/components/schemas/Diagnostic"}}
+        }
+      },
+      "ProductStatus": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string"},
+          "synced": {"type": "string", "format": "date-time", "nullable": true},
+          "error": {"type": "string", "description": "Error of the last sync, the data of the previous successful sync is still served"},
+          "controls": {"type": "integer"},
+          "errors": {"type": "integer"},
+          "warnings": {"type": "integer"}
+        }
+      }
+    }
+  }
+}
+`
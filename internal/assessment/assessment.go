@@ -0,0 +1,218 @@
+// Package assessment applies the results of assessments, by an assessor or a
+// scanner, to the controls of a component.
+package assessment
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// Result is the outcome of assessing a control.
+type Result struct {
+	ControlKey string
+	// Status is the implementation status the assessment found, empty when
+	// the assessment doesn't tell
+	Status string
+	// Verification references what the assessment is based on
+	Verification common.VerificationReference
+}
+
+// Report lists what applying results to a component did.
+type Report struct {
+	// Updated are the controls whose status or verifications changed
+	Updated []string `json:"updated"`
+	// Missing are the assessed controls the component doesn't have
+	Missing []string `json:"missing"`
+}
+
+// Combine returns the status of a control given the status found by two
+// assessments: a control is only complete when every assessment found it
+// complete.
+func Combine(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "", a == b:
+		return a
+	case a == parser.StatusNotApplicable:
+		return b
+	case b == parser.StatusNotApplicable:
+		return a
+	default:
+		return parser.StatusPartial
+	}
+}
+
+// Apply sets the status found by the results on the controls of the
+// component, and adds the verifications of the results to the component
+// and to the covered_by list of the controls. Verifications are matched by
+// key, so importing the same results twice doesn't duplicate them.
+func Apply(c *v3c.Component, results []Result) Report {
+	status := make(map[string]string)
+	byControl := make(map[string][]common.VerificationReference)
+	for _, r := range results {
+		status[r.ControlKey] = Combine(status[r.ControlKey], r.Status)
+		if r.Verification.Key != "" {
+			byControl[r.ControlKey] = append(byControl[r.ControlKey], r.Verification)
+		}
+	}
+
+	report := Report{Updated: []string{}, Missing: []string{}}
+	seen := make(map[string]bool)
+	for i := range c.Satisfies {
+		s := &c.Satisfies[i]
+		if _, ok := status[s.ControlKey]; !ok {
+			continue
+		}
+		seen[s.ControlKey] = true
+
+		changed := false
+		if st := status[s.ControlKey]; st != "" && st != s.ImplementationStatus {
+			s.ImplementationStatus = st
+			changed = true
+		}
+		for _, v := range byControl[s.ControlKey] {
+			if addVerification(c, s, v) {
+				changed = true
+			}
+		}
+		if changed {
+			report.Updated = append(report.Updated, s.ControlKey)
+		}
+	}
+
+	for key := range status {
+		if !seen[key] {
+			report.Missing = append(report.Missing, key)
+		}
+	}
+	sortKeys(report.Updated)
+	sortKeys(report.Missing)
+	return report
+}
+
+// addVerification returns true if the verification or the reference to it
+// is new.
+func addVerification(c *v3c.Component, s *v3c.Satisfies, v common.VerificationReference) bool {
+	changed := true
+	found := false
+	for i, existing := range c.Verifications {
+		if existing.Key == v.Key {
+			found = true
+			changed = existing != v
+			c.Verifications[i] = v
+		}
+	}
+	if !found {
+		c.Verifications = append(c.Verifications, v)
+	}
+
+	for _, cb := range s.CoveredBy {
+		if cb.VerificationKey == v.Key && cb.ComponentKey == "" {
+			return changed
+		}
+	}
+	s.CoveredBy = append(s.CoveredBy, common.CoveredBy{VerificationKey: v.Key})
+	return true
+}
+
+func sortKeys(keys []string) {
+	sort.Slice(keys, func(i, j int) bool {
+		return sortorder.NaturalLess(keys[i], keys[j])
+	})
+}
+
+// Disagreement is a control whose status in the sheet isn't the status
+// derived from the results.
+type Disagreement struct {
+	ControlKey string `json:"control_key"`
+	Sheet      string `json:"sheet"`
+	Results    string `json:"results"`
+}
+
+// Comparison is the outcome of comparing the sheet with results.
+type Comparison struct {
+	// Agreed is the number of controls where the sheet and results agree
+	Agreed        int            `json:"agreed"`
+	Disagreements []Disagreement `json:"disagreements"`
+	// Missing are the assessed controls the sheet doesn't have
+	Missing []string `json:"missing"`
+}
+
+// Compare compares the status of the controls in the sheet with the status
+// derived from the results.
+func Compare(data parser.Data, results []Result) Comparison {
+	status := make(map[string]string)
+	for _, r := range results {
+		status[r.ControlKey] = Combine(status[r.ControlKey], r.Status)
+	}
+
+	sheet := make(map[string]string)
+	for _, family := range data {
+		for key, s := range family {
+			sheet[key] = s.ImplementationStatus
+		}
+	}
+
+	cmp := Comparison{Disagreements: []Disagreement{}, Missing: []string{}}
+	for key, st := range status {
+		sh, ok := sheet[key]
+		switch {
+		case !ok:
+			cmp.Missing = append(cmp.Missing, key)
+		case st == "" || st == sh:
+			cmp.Agreed++
+		default:
+			cmp.Disagreements = append(cmp.Disagreements, Disagreement{ControlKey: key, Sheet: sh, Results: st})
+		}
+	}
+	sort.Slice(cmp.Disagreements, func(i, j int) bool {
+		return sortorder.NaturalLess(cmp.Disagreements[i].ControlKey, cmp.Disagreements[j].ControlKey)
+	})
+	sortKeys(cmp.Missing)
+	return cmp
+}
+
+// WriteText writes the comparison as a table of the disagreements followed
+// by the controls missing from the sheet.
+func (c Comparison) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "%d controls agree, %d disagree, %d are not in the sheet\n", c.Agreed, len(c.Disagreements), len(c.Missing))
+	if len(c.Disagreements) > 0 {
+		fmt.Fprintln(w)
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "CONTROL\tSHEET\tRESULTS")
+		for _, d := range c.Disagreements {
+			sheet := d.Sheet
+			if sheet == "" {
+				sheet = "-"
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", d.ControlKey, sheet, d.Results)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	if len(c.Missing) > 0 {
+		fmt.Fprintf(w, "\nNot in the sheet:\n")
+		for _, k := range c.Missing {
+			fmt.Fprintf(w, "  %s\n", k)
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the comparison as JSON.
+func (c Comparison) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c)
+}
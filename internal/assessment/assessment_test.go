@@ -0,0 +1,108 @@
+package assessment
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+func TestCombine(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{"", parser.StatusComplete, parser.StatusComplete},
+		{parser.StatusComplete, "", parser.StatusComplete},
+		{parser.StatusComplete, parser.StatusComplete, parser.StatusComplete},
+		{parser.StatusNone, parser.StatusNone, parser.StatusNone},
+		{parser.StatusComplete, parser.StatusNone, parser.StatusPartial},
+		{parser.StatusNotApplicable, parser.StatusNone, parser.StatusNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"+"+tt.b, func(t *testing.T) {
+			if got := Combine(tt.a, tt.b); got != tt.want {
+				t.Errorf("Combine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func verification(key string) common.VerificationReference {
+	return common.VerificationReference{Key: key, GeneralReference: common.GeneralReference{Name: key, Path: "results.json"}}
+}
+
+func TestApply(t *testing.T) {
+	c := &v3c.Component{
+		Satisfies: []v3c.Satisfies{
+			{ControlKey: "AC-2", ImplementationStatus: parser.StatusPlanned},
+			{ControlKey: "AC-3", ImplementationStatus: parser.StatusComplete},
+			{ControlKey: "AC-4", ImplementationStatus: parser.StatusPlanned},
+		},
+	}
+	results := []Result{
+		{ControlKey: "AC-2", Status: parser.StatusComplete, Verification: verification("a")},
+		{ControlKey: "AC-2", Status: parser.StatusNone, Verification: verification("b")},
+		{ControlKey: "AC-3", Status: parser.StatusComplete},
+		{ControlKey: "AC-6 (9)", Status: parser.StatusComplete, Verification: verification("c")},
+		{ControlKey: "AC-6", Status: parser.StatusComplete},
+	}
+
+	got := Apply(c, results)
+	want := Report{Updated: []string{"AC-2"}, Missing: []string{"AC-6", "AC-6 (9)"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Apply() = %v, want %v", got, want)
+	}
+
+	wantCtrl := v3c.Satisfies{
+		ControlKey:           "AC-2",
+		ImplementationStatus: parser.StatusPartial,
+		CoveredBy:            common.CoveredByList{{VerificationKey: "a"}, {VerificationKey: "b"}},
+	}
+	if !reflect.DeepEqual(c.Satisfies[0], wantCtrl) {
+		t.Errorf("got control %+v, want %+v", c.Satisfies[0], wantCtrl)
+	}
+	wantVerifications := common.VerificationReferences{verification("a"), verification("b")}
+	if !reflect.DeepEqual(c.Verifications, wantVerifications) {
+		t.Errorf("got verifications %+v, want %+v", c.Verifications, wantVerifications)
+	}
+
+	// applying the same results again changes nothing
+	again := Apply(c, results)
+	if len(again.Updated) != 0 || len(c.Verifications) != 2 || len(c.Satisfies[0].CoveredBy) != 2 {
+		t.Errorf("applying twice changed the component: %v, %+v", again, c)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	data := parser.Data{
+		"AC-Access_Control": {
+			"AC-2":     {ControlKey: "AC-2", ImplementationStatus: parser.StatusComplete},
+			"AC-2 (5)": {ControlKey: "AC-2 (5)", ImplementationStatus: parser.StatusComplete},
+			"AC-12":    {ControlKey: "AC-12"},
+		},
+	}
+	results := []Result{
+		{ControlKey: "AC-2", Status: parser.StatusComplete},
+		{ControlKey: "AC-2 (5)", Status: parser.StatusComplete},
+		{ControlKey: "AC-2 (5)", Status: parser.StatusNone},
+		{ControlKey: "AC-12", Status: parser.StatusComplete},
+		{ControlKey: "SC-10", Status: parser.StatusNone},
+	}
+
+	got := Compare(data, results)
+	want := Comparison{
+		Agreed: 1,
+		Disagreements: []Disagreement{
+			{ControlKey: "AC-2 (5)", Sheet: parser.StatusComplete, Results: parser.StatusPartial},
+			{ControlKey: "AC-12", Sheet: "", Results: parser.StatusComplete},
+		},
+		Missing: []string{"SC-10"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Compare() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,94 @@
+package baseline
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vbom.ml/util/sortorder"
+)
+
+// Baseline is a set of controls and enhancements required for a system.
+type Baseline struct {
+	Name  string
+	Title string
+	// Controls holds the control keys, in the format produced by the parser
+	// (e.g. "AC-2" or "AC-2 (1)"), in natural order.
+	Controls []string
+	set      map[string]bool
+}
+
+// Contains returns true if the baseline requires the control key.
+func (b Baseline) Contains(controlKey string) bool {
+	return b.set[controlKey]
+}
+
+var baselines = make(map[string]Baseline)
+
+func init() {
+	nist := parseTable(nistTable)
+	register("nist-low", "NIST SP 800-53B Low", nist['L'])
+	register("nist-moderate", "NIST SP 800-53B Moderate", nist['M'])
+	register("nist-high", "NIST SP 800-53B High", nist['H'])
+	register("nist-privacy", "NIST SP 800-53B Privacy", nist['P'])
+
+	register("fedramp-low", "FedRAMP Low", append(append([]string(nil), nist['L']...), fedrampLowAdditions...))
+	register("fedramp-moderate", "FedRAMP Moderate", append(append([]string(nil), nist['M']...), fedrampModerateAdditions...))
+	register("fedramp-high", "FedRAMP High", append(append([]string(nil), nist['H']...), fedrampHighAdditions...))
+	register("fedramp-li-saas", "FedRAMP Tailored LI-SaaS", fedrampLISaaS)
+}
+
+// parseTable parses a table of controls followed by the letters of the
+// baselines that select them, and returns the controls of each baseline.
+func parseTable(table string) map[rune][]string {
+	controls := make(map[rune][]string)
+	for _, line := range strings.Split(table, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		key := fields[0]
+		letters := fields[1:]
+		if len(letters) > 0 && strings.HasPrefix(letters[0], "(") {
+			key += " " + letters[0]
+			letters = letters[1:]
+		}
+		for _, l := range letters {
+			controls[rune(l[0])] = append(controls[rune(l[0])], key)
+		}
+	}
+	return controls
+}
+
+func register(name, title string, controls []string) {
+	b := Baseline{Name: name, Title: title, set: make(map[string]bool)}
+	for _, c := range controls {
+		if !b.set[c] {
+			b.set[c] = true
+			b.Controls = append(b.Controls, c)
+		}
+	}
+	sort.Slice(b.Controls, func(i, j int) bool {
+		return sortorder.NaturalLess(b.Controls[i], b.Controls[j])
+	})
+	baselines[name] = b
+}
+
+// Get returns a baseline by name, see Names.
+func Get(name string) (Baseline, error) {
+	b, ok := baselines[strings.ToLower(name)]
+	if !ok {
+		return Baseline{}, fmt.Errorf("unknown baseline %q, expected one of %s", name, strings.Join(Names(), ", "))
+	}
+	return b, nil
+}
+
+// Names returns the names of all the available baselines.
+func Names() []string {
+	var names []string
+	for name := range baselines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
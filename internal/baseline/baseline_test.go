@@ -0,0 +1,138 @@
+package baseline
+
+import (
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseline string
+		contains []string
+		excludes []string
+		wantErr  bool
+	}{
+		{
+			"NIST Low",
+			"nist-low",
+			[]string{"AC-1", "AC-2", "IA-2 (1)", "SR-12"},
+			[]string{"AC-2 (1)", "AC-4", "PT-1"},
+			false,
+		},
+		{
+			"NIST Moderate",
+			"NIST-Moderate",
+			[]string{"AC-2 (1)", "AC-4", "SC-28 (1)"},
+			[]string{"AC-2 (11)", "AC-10"},
+			false,
+		},
+		{
+			"NIST High",
+			"nist-high",
+			[]string{"AC-2 (11)", "AC-10", "SI-7 (15)"},
+			[]string{"PT-1"},
+			false,
+		},
+		{
+			"NIST Privacy",
+			"nist-privacy",
+			[]string{"PT-1", "PT-5 (2)", "SI-18"},
+			[]string{"AC-2", "SC-7"},
+			false,
+		},
+		{
+			"FedRAMP Moderate adds to NIST Moderate",
+			"fedramp-moderate",
+			[]string{"AC-2 (1)", "SC-45", "SI-4 (23)"},
+			[]string{"AC-10"},
+			false,
+		},
+		{
+			"FedRAMP Low adds to NIST Low",
+			"fedramp-low",
+			[]string{"AC-2", "CA-2 (1)", "CA-8", "SC-28 (1)"},
+			[]string{"AC-2 (1)", "CA-8 (1)"},
+			false,
+		},
+		{
+			"FedRAMP High adds to NIST High",
+			"fedramp-high",
+			[]string{"AC-2 (7)", "AC-6 (8)", "IR-9 (4)", "SC-45 (1)", "SI-4 (19)"},
+			[]string{"PT-1"},
+			false,
+		},
+		{
+			"FedRAMP LI-SaaS only holds the documented controls",
+			"fedramp-li-saas",
+			[]string{"CA-2 (1)", "IA-2 (12)", "RA-5", "SC-7", "SI-4"},
+			[]string{"AC-1", "PE-2", "PS-3", "AC-2 (1)"},
+			false,
+		},
+		{
+			"Unknown baseline returns an error",
+			"iso-27001",
+			nil,
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := Get(tt.baseline)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for _, c := range tt.contains {
+				if !b.Contains(c) {
+					t.Errorf("Get(%q) doesn't contain %s", tt.baseline, c)
+				}
+			}
+			for _, c := range tt.excludes {
+				if b.Contains(c) {
+					t.Errorf("Get(%q) contains %s", tt.baseline, c)
+				}
+			}
+		})
+	}
+}
+
+func TestBaselinesAreNested(t *testing.T) {
+	pairs := [][2]string{
+		{"nist-low", "nist-moderate"},
+		{"nist-moderate", "nist-high"},
+		{"nist-low", "fedramp-low"},
+		{"nist-moderate", "fedramp-moderate"},
+		{"fedramp-moderate", "fedramp-high"},
+		{"fedramp-li-saas", "fedramp-low"},
+	}
+	for _, p := range pairs {
+		smaller, _ := Get(p[0])
+		larger, _ := Get(p[1])
+		for _, c := range smaller.Controls {
+			if !larger.Contains(c) {
+				t.Errorf("%s contains %s but %s doesn't", p[0], c, p[1])
+			}
+		}
+	}
+}
+
+func TestBaselineSizes(t *testing.T) {
+	want := map[string]int{
+		"nist-low":         149,
+		"nist-moderate":    287,
+		"nist-high":        370,
+		"fedramp-low":      155,
+		"fedramp-moderate": 321,
+		"fedramp-high":     404,
+		"fedramp-li-saas":  40,
+	}
+	for name, n := range want {
+		b, err := Get(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(b.Controls) != n {
+			t.Errorf("Get(%q) has %d controls, want %d", name, len(b.Controls), n)
+		}
+	}
+}
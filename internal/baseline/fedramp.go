@@ -0,0 +1,108 @@
+package baseline
+
+// The FedRAMP (Rev. 5) baselines are built on top of the NIST baselines of
+// the same impact level, with the following controls added by FedRAMP.
+
+var fedrampLowAdditions = []string{
+	"CA-2 (1)",
+	"CA-8",
+	"SC-8",
+	"SC-8 (1)",
+	"SC-28",
+	"SC-28 (1)",
+}
+
+var fedrampModerateAdditions = []string{
+	"AC-2 (7)",
+	"AC-2 (9)",
+	"AC-2 (12)",
+	"AC-4 (21)",
+	"CA-2 (3)",
+	"CA-8",
+	"CA-8 (1)",
+	"CA-8 (2)",
+	"CM-5 (1)",
+	"CM-6 (1)",
+	"IA-2 (5)",
+	"IA-2 (6)",
+	"IA-5 (7)",
+	"IR-9",
+	"IR-9 (2)",
+	"IR-9 (3)",
+	"IR-9 (4)",
+	"MA-5 (1)",
+	"PS-3 (3)",
+	"RA-5 (3)",
+	"SA-9 (1)",
+	"SA-9 (4)",
+	"SA-9 (5)",
+	"SA-11 (1)",
+	"SA-11 (2)",
+	"SC-7 (12)",
+	"SC-7 (18)",
+	"SC-45",
+	"SC-45 (1)",
+	"SI-2 (3)",
+	"SI-4 (1)",
+	"SI-4 (16)",
+	"SI-4 (18)",
+	"SI-4 (23)",
+}
+
+var fedrampHighAdditions = append([]string{
+	"AC-6 (8)",
+	"IA-5 (8)",
+	"IA-5 (13)",
+	"RA-5 (8)",
+	"SC-7 (10)",
+	"SC-7 (20)",
+	"SI-4 (11)",
+	"SI-4 (19)",
+}, fedrampModerateAdditions...)
+
+// fedrampLISaaS lists the controls of the FedRAMP Tailored LI-SaaS baseline
+// that the provider documents and has tested. The other controls of the Low
+// baseline are only attested, inherited, or left to the agency, so they
+// aren't expected in the sheet.
+var fedrampLISaaS = []string{
+	"AC-2",
+	"AC-3",
+	"AC-7",
+	"AC-17",
+	"AU-2",
+	"AU-3",
+	"AU-6",
+	"AU-12",
+	"CA-2",
+	"CA-2 (1)",
+	"CA-5",
+	"CA-7",
+	"CA-8",
+	"CM-2",
+	"CM-6",
+	"CM-7",
+	"CM-8",
+	"CP-9",
+	"IA-2",
+	"IA-2 (1)",
+	"IA-2 (2)",
+	"IA-2 (8)",
+	"IA-2 (12)",
+	"IA-5",
+	"IA-5 (1)",
+	"IR-4",
+	"IR-6",
+	"PL-2",
+	"RA-3",
+	"RA-5",
+	"SA-9",
+	"SC-7",
+	"SC-8",
+	"SC-8 (1)",
+	"SC-13",
+	"SC-28",
+	"SC-28 (1)",
+	"SI-2",
+	"SI-3",
+	"SI-4",
+}
@@ -0,0 +1,424 @@
+package baseline
+
+// nistTable lists the controls selected by the NIST SP 800-53B (Rev. 5)
+// baselines, one control or enhancement per line followed by the baselines
+// that select it: L(ow), M(oderate), H(igh) and P(rivacy).
+const nistTable = `
+AC-1        L M H P
+AC-2        L M H
+AC-2 (1)      M H
+AC-2 (2)      M H
+AC-2 (3)      M H
+AC-2 (4)      M H
+AC-2 (5)      M H
+AC-2 (11)       H
+AC-2 (12)       H
+AC-2 (13)     M H
+AC-3        L M H
+AC-3 (14)           P
+AC-4          M H
+AC-4 (4)        H
+AC-5          M H
+AC-6          M H
+AC-6 (1)      M H
+AC-6 (2)      M H
+AC-6 (3)        H
+AC-6 (5)      M H
+AC-6 (7)      M H
+AC-6 (9)      M H
+AC-6 (10)     M H
+AC-7        L M H
+AC-8        L M H
+AC-10           H
+AC-11         M H
+AC-11 (1)     M H
+AC-12         M H
+AC-14       L M H
+AC-17       L M H
+AC-17 (1)     M H
+AC-17 (2)     M H
+AC-17 (3)     M H
+AC-17 (4)     M H
+AC-18       L M H
+AC-18 (1)     M H
+AC-18 (3)     M H
+AC-18 (4)       H
+AC-18 (5)       H
+AC-19       L M H
+AC-19 (5)     M H
+AC-20       L M H
+AC-20 (1)     M H
+AC-20 (2)     M H
+AC-21         M H
+AC-22       L M H
+
+AT-1        L M H P
+AT-2        L M H P
+AT-2 (2)    L M H
+AT-2 (3)      M H
+AT-3        L M H P
+AT-3 (5)            P
+AT-4        L M H P
+
+AU-1        L M H P
+AU-2        L M H P
+AU-3        L M H
+AU-3 (1)      M H
+AU-3 (3)            P
+AU-4        L M H
+AU-5        L M H
+AU-5 (1)        H
+AU-5 (2)        H
+AU-6        L M H
+AU-6 (1)      M H
+AU-6 (3)      M H
+AU-6 (5)        H
+AU-6 (6)        H
+AU-7          M H
+AU-7 (1)      M H
+AU-8        L M H
+AU-9        L M H
+AU-9 (2)        H
+AU-9 (3)        H
+AU-9 (4)      M H
+AU-10           H
+AU-11       L M H P
+AU-12       L M H
+AU-12 (1)       H
+AU-12 (3)       H
+
+CA-1        L M H P
+CA-2        L M H P
+CA-2 (1)      M H
+CA-2 (2)        H
+CA-3        L M H
+CA-3 (6)        H
+CA-5        L M H P
+CA-6        L M H P
+CA-7        L M H P
+CA-7 (1)      M H
+CA-7 (4)    L M H P
+CA-8            H
+CA-8 (1)        H
+CA-9        L M H
+
+CM-1        L M H
+CM-2        L M H
+CM-2 (2)      M H
+CM-2 (3)      M H
+CM-2 (7)      M H
+CM-3          M H
+CM-3 (1)        H
+CM-3 (2)      M H
+CM-3 (4)      M H
+CM-3 (6)        H
+CM-4        L M H P
+CM-4 (1)        H
+CM-4 (2)      M H
+CM-5        L M H
+CM-5 (1)        H
+CM-6        L M H
+CM-6 (1)        H
+CM-6 (2)        H
+CM-7        L M H
+CM-7 (1)      M H
+CM-7 (2)      M H
+CM-7 (5)      M H
+CM-8        L M H
+CM-8 (1)      M H
+CM-8 (2)        H
+CM-8 (3)      M H
+CM-8 (4)        H
+CM-9          M H
+CM-10       L M H
+CM-11       L M H
+CM-12         M H
+CM-12 (1)     M H
+
+CP-1        L M H
+CP-2        L M H
+CP-2 (1)      M H
+CP-2 (2)        H
+CP-2 (3)      M H
+CP-2 (5)        H
+CP-2 (8)      M H
+CP-3        L M H
+CP-3 (1)        H
+CP-4        L M H
+CP-4 (1)      M H
+CP-4 (2)        H
+CP-6          M H
+CP-6 (1)      M H
+CP-6 (2)        H
+CP-6 (3)      M H
+CP-7          M H
+CP-7 (1)      M H
+CP-7 (2)      M H
+CP-7 (3)      M H
+CP-7 (4)        H
+CP-8          M H
+CP-8 (1)      M H
+CP-8 (2)      M H
+CP-8 (3)        H
+CP-8 (4)        H
+CP-9        L M H
+CP-9 (1)      M H
+CP-9 (2)        H
+CP-9 (3)        H
+CP-9 (5)        H
+CP-9 (8)      M H
+CP-10       L M H
+CP-10 (2)     M H
+CP-10 (4)       H
+
+IA-1        L M H
+IA-2        L M H
+IA-2 (1)    L M H
+IA-2 (2)    L M H
+IA-2 (5)        H
+IA-2 (8)    L M H
+IA-2 (12)   L M H
+IA-3          M H
+IA-4        L M H
+IA-4 (4)      M H
+IA-5        L M H
+IA-5 (1)    L M H
+IA-5 (2)      M H
+IA-5 (6)      M H
+IA-6        L M H
+IA-7        L M H
+IA-8        L M H
+IA-8 (1)    L M H
+IA-8 (2)    L M H
+IA-8 (4)    L M H
+IA-11       L M H
+IA-12         M H
+IA-12 (2)     M H
+IA-12 (3)     M H
+IA-12 (4)       H
+IA-12 (5)     M H
+
+IR-1        L M H P
+IR-2        L M H P
+IR-2 (1)        H
+IR-2 (2)        H
+IR-2 (3)            P
+IR-3          M H P
+IR-3 (2)      M H
+IR-4        L M H P
+IR-4 (1)      M H
+IR-4 (4)        H
+IR-4 (11)       H
+IR-5        L M H P
+IR-5 (1)        H
+IR-6        L M H P
+IR-6 (1)      M H
+IR-6 (3)      M H
+IR-7        L M H P
+IR-7 (1)      M H
+IR-8        L M H P
+IR-8 (1)            P
+
+MA-1        L M H
+MA-2        L M H
+MA-2 (2)        H
+MA-3          M H
+MA-3 (1)      M H
+MA-3 (2)      M H
+MA-3 (3)      M H
+MA-4        L M H
+MA-4 (3)        H
+MA-5        L M H
+MA-5 (1)        H
+MA-6          M H
+
+MP-1        L M H
+MP-2        L M H
+MP-3          M H
+MP-4          M H
+MP-5          M H
+MP-6        L M H P
+MP-6 (1)        H
+MP-6 (2)        H
+MP-6 (3)        H
+MP-7        L M H
+
+PE-1        L M H
+PE-2        L M H
+PE-3        L M H
+PE-3 (1)        H
+PE-4          M H
+PE-5          M H
+PE-6        L M H
+PE-6 (1)      M H
+PE-6 (4)        H
+PE-8        L M H
+PE-8 (1)        H
+PE-8 (3)            P
+PE-9          M H
+PE-10         M H
+PE-11         M H
+PE-11 (1)       H
+PE-12       L M H
+PE-13       L M H
+PE-13 (1)     M H
+PE-13 (2)       H
+PE-14       L M H
+PE-15       L M H
+PE-15 (1)       H
+PE-16       L M H
+PE-17         M H
+PE-18           H
+
+PL-1        L M H P
+PL-2        L M H P
+PL-4        L M H P
+PL-4 (1)    L M H P
+PL-8          M H
+PL-9                P
+PL-10       L M H
+PL-11       L M H
+
+PS-1        L M H
+PS-2        L M H
+PS-3        L M H
+PS-4        L M H
+PS-4 (2)        H
+PS-5        L M H
+PS-6        L M H P
+PS-7        L M H
+PS-8        L M H
+PS-9        L M H
+
+PT-1                P
+PT-2                P
+PT-3                P
+PT-4                P
+PT-5                P
+PT-5 (2)            P
+PT-6                P
+PT-6 (1)            P
+PT-6 (2)            P
+PT-7                P
+PT-7 (1)            P
+PT-7 (2)            P
+PT-8                P
+
+RA-1        L M H P
+RA-2        L M H
+RA-3        L M H P
+RA-3 (1)    L M H
+RA-5        L M H
+RA-5 (2)    L M H
+RA-5 (4)        H
+RA-5 (5)      M H
+RA-5 (11)   L M H
+RA-7        L M H P
+RA-8                P
+RA-9          M H
+
+SA-1        L M H P
+SA-2        L M H P
+SA-3        L M H P
+SA-4        L M H P
+SA-4 (1)      M H
+SA-4 (2)      M H
+SA-4 (5)        H
+SA-4 (9)      M H
+SA-4 (10)   L M H
+SA-5        L M H
+SA-8        L M H P
+SA-8 (33)           P
+SA-9        L M H P
+SA-9 (2)      M H
+SA-10         M H
+SA-11         M H P
+SA-15         M H
+SA-15 (3)     M H
+SA-16           H
+SA-17           H
+SA-21           H
+SA-22       L M H
+
+SC-1        L M H
+SC-2          M H
+SC-3            H
+SC-4          M H
+SC-5        L M H
+SC-7        L M H
+SC-7 (3)      M H
+SC-7 (4)      M H
+SC-7 (5)      M H
+SC-7 (7)      M H
+SC-7 (8)      M H
+SC-7 (18)       H
+SC-7 (21)       H
+SC-7 (24)           P
+SC-8          M H
+SC-8 (1)      M H
+SC-10         M H
+SC-12       L M H
+SC-12 (1)       H
+SC-13       L M H
+SC-15       L M H
+SC-17         M H
+SC-18         M H
+SC-20       L M H
+SC-21       L M H
+SC-22       L M H
+SC-23         M H
+SC-24           H
+SC-28         M H
+SC-28 (1)     M H
+SC-39       L M H
+
+SI-1        L M H P
+SI-2        L M H
+SI-2 (2)      M H
+SI-3        L M H
+SI-4        L M H
+SI-4 (2)      M H
+SI-4 (4)      M H
+SI-4 (5)      M H
+SI-4 (10)       H
+SI-4 (12)       H
+SI-4 (14)       H
+SI-4 (20)       H
+SI-4 (22)       H
+SI-5        L M H
+SI-5 (1)        H
+SI-6            H
+SI-7          M H
+SI-7 (1)      M H
+SI-7 (2)        H
+SI-7 (5)        H
+SI-7 (7)      M H
+SI-7 (15)       H
+SI-8          M H
+SI-8 (2)      M H
+SI-10         M H
+SI-11         M H
+SI-12       L M H P
+SI-12 (1)           P
+SI-12 (2)           P
+SI-12 (3)           P
+SI-16         M H
+SI-18               P
+SI-18 (4)           P
+SI-19               P
+
+SR-1        L M H
+SR-2        L M H
+SR-2 (1)    L M H
+SR-3        L M H
+SR-5        L M H
+SR-6          M H
+SR-8        L M H
+SR-9            H
+SR-9 (1)        H
+SR-10       L M H
+SR-11       L M H
+SR-11 (1)   L M H
+SR-11 (2)   L M H
+SR-12       L M H
+`
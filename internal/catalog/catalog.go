@@ -0,0 +1,203 @@
+// Package catalog describes the statement parts of the NIST SP 800-53
+// controls, that is the narrative keys a control is expected to be answered
+// with.
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/carlosmmatos/automate-compliance/internal/oscal"
+)
+
+// maxDepth is the depth of the narrative keys the parser produces, e.g.
+// "b.2". Deeper parts are answered by their parent.
+const maxDepth = 2
+
+// Catalog maps control keys to the narrative keys of their statement parts,
+// in catalog order, e.g. "d", "d.1", "d.2".
+type Catalog struct {
+	Title string
+	Parts map[string][]string
+	// Text is the statement of the controls, only known when parsed from an
+	// OSCAL catalog
+	Text map[string]string
+}
+
+// Default returns the catalog built into the binary, see nistTable.
+func Default() *Catalog {
+	c := &Catalog{Title: "NIST SP 800-53 Rev. 5 (built-in subset)", Parts: make(map[string][]string), Text: make(map[string]string)}
+	for _, line := range strings.Split(nistTable, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		key := fields[0]
+		parts := fields[1:]
+		if len(parts) > 0 && strings.HasPrefix(parts[0], "(") {
+			key += " " + parts[0]
+			parts = parts[1:]
+		}
+		c.Parts[key] = parts
+	}
+	return c
+}
+
+// ControlParts returns the narrative keys of the parts of a control, and
+// whether the catalog has the control.
+func (c *Catalog) ControlParts(controlKey string) ([]string, bool) {
+	parts, ok := c.Parts[controlKey]
+	return parts, ok
+}
+
+// ControlText returns the statement of a control, or "" when unknown.
+func (c *Catalog) ControlText(controlKey string) string {
+	return c.Text[controlKey]
+}
+
+// Parent returns the narrative key of the part containing another, e.g. "d"
+// for "d.1", or "" for the statement of the control itself.
+func Parent(narrativeKey string) string {
+	if i := strings.LastIndex(narrativeKey, "."); i >= 0 {
+		return narrativeKey[:i]
+	}
+	return ""
+}
+
+type oscalCatalog struct {
+	Catalog struct {
+		Metadata struct {
+			Title string `json:"title"`
+		} `json:"metadata"`
+		Groups   []oscalGroup   `json:"groups"`
+		Controls []oscalControl `json:"controls"`
+	} `json:"catalog"`
+}
+
+type oscalGroup struct {
+	Groups   []oscalGroup   `json:"groups"`
+	Controls []oscalControl `json:"controls"`
+}
+
+type oscalControl struct {
+	ID       string         `json:"id"`
+	Parts    []oscalPart    `json:"parts"`
+	Controls []oscalControl `json:"controls"`
+}
+
+type oscalPart struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Props []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"props"`
+	Prose string      `json:"prose"`
+	Parts []oscalPart `json:"parts"`
+}
+
+// paramRe matches the parameters inserted in the prose, e.g.
+// "{{ insert: param, ac-02_odp.01 }}".
+var paramRe = regexp.MustCompile(`{{\s*insert:\s*param,[^}]*}}`)
+
+// text returns the prose of a part and its items, a line per item prefixed
+// with its label, e.g. "a. Define and document...".
+func (p oscalPart) text() []string {
+	var lines []string
+	if prose := strings.TrimSpace(paramRe.ReplaceAllString(p.Prose, "[Assignment]")); prose != "" {
+		for _, prop := range p.Props {
+			if prop.Name == "label" {
+				prose = prop.Value + " " + prose
+			}
+		}
+		lines = append(lines, prose)
+	}
+	for _, item := range p.Parts {
+		if item.Name == "item" {
+			lines = append(lines, item.text()...)
+		}
+	}
+	return lines
+}
+
+// Parse reads an OSCAL JSON catalog, such as the NIST SP 800-53 Rev. 5
+// catalog of usnistgov/oscal-content.
+func Parse(r io.Reader) (*Catalog, error) {
+	var doc oscalCatalog
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	c := &Catalog{Title: doc.Catalog.Metadata.Title, Parts: make(map[string][]string), Text: make(map[string]string)}
+	c.addGroup(oscalGroup{Groups: doc.Catalog.Groups, Controls: doc.Catalog.Controls})
+	if len(c.Parts) == 0 {
+		return nil, fmt.Errorf("no NIST control found in the catalog")
+	}
+	return c, nil
+}
+
+func (c *Catalog) addGroup(g oscalGroup) {
+	for _, sub := range g.Groups {
+		c.addGroup(sub)
+	}
+	for _, ctrl := range g.Controls {
+		c.addControl(ctrl)
+	}
+}
+
+func (c *Catalog) addControl(ctrl oscalControl) {
+	key, err := oscal.ControlKey(ctrl.ID)
+	if err != nil {
+		return
+	}
+	parts := []string{}
+	for _, p := range ctrl.Parts {
+		if p.Name == "statement" {
+			parts = appendItems(parts, p.Parts)
+			if text := p.text(); len(text) > 0 {
+				c.Text[key] = strings.Join(text, "\n")
+			}
+		}
+	}
+	c.Parts[key] = parts
+	for _, enh := range ctrl.Controls {
+		c.addControl(enh)
+	}
+}
+
+// appendItems appends the narrative keys of the items of a statement, e.g.
+// "d.1" for "ac-2_smt.d.1".
+func appendItems(keys []string, items []oscalPart) []string {
+	for _, item := range items {
+		if item.Name != "item" {
+			continue
+		}
+		i := strings.Index(item.ID, "_smt.")
+		if i < 0 {
+			continue
+		}
+		key := item.ID[i+len("_smt."):]
+		if strings.Count(key, ".") >= maxDepth {
+			continue
+		}
+		keys = appendItems(append(keys, key), item.Parts)
+	}
+	return keys
+}
+
+// Load reads an OSCAL JSON catalog file.
+func Load(path string) (*Catalog, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	c, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return c, nil
+}
@@ -0,0 +1,76 @@
+package catalog
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const oscalDoc = `{"catalog": {
+  "metadata": {"title": "NIST Special Publication 800-53 Revision 5"},
+  "groups": [{"id": "ac", "controls": [{
+    "id": "ac-2",
+    "parts": [
+      {"id": "ac-2_smt", "name": "statement", "parts": [
+        {"id": "ac-2_smt.a", "name": "item", "props": [{"name": "label", "value": "a."}],
+         "prose": "Define the types of accounts allowed: {{ insert: param, ac-02_odp.01 }};"},
+        {"id": "ac-2_smt.d", "name": "item", "parts": [
+          {"id": "ac-2_smt.d.1", "name": "item", "parts": [
+            {"id": "ac-2_smt.d.1.a", "name": "item"}
+          ]}
+        ]}
+      ]},
+      {"id": "ac-2_gdn", "name": "guidance"}
+    ],
+    "controls": [{"id": "ac-2.1", "parts": [{"id": "ac-2.1_smt", "name": "statement", "prose": "Support the management of accounts."}]}]
+  }]}]
+}}`
+
+func TestParse(t *testing.T) {
+	c, err := Parse(strings.NewReader(oscalDoc))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	want := map[string][]string{
+		"AC-2":     {"a", "d", "d.1"},
+		"AC-2 (1)": {},
+	}
+	if c.Title != "NIST Special Publication 800-53 Revision 5" || !reflect.DeepEqual(c.Parts, want) {
+		t.Errorf("Parse() = %q %v, want %v", c.Title, c.Parts, want)
+	}
+
+	wantText := map[string]string{
+		"AC-2":     "a. Define the types of accounts allowed: [Assignment];",
+		"AC-2 (1)": "Support the management of accounts.",
+	}
+	if !reflect.DeepEqual(c.Text, wantText) {
+		t.Errorf("Parse() text = %q, want %q", c.Text, wantText)
+	}
+
+	if _, err := Parse(strings.NewReader(`{"catalog": {}}`)); err == nil {
+		t.Errorf("Parse() of an empty catalog should fail")
+	}
+}
+
+func TestDefault(t *testing.T) {
+	c := Default()
+	tests := []struct {
+		control string
+		want    []string
+		known   bool
+	}{
+		{"AC-3", []string{}, true},
+		{"AC-2 (3)", []string{"a", "b", "c", "d"}, true},
+		{"CM-2", []string{"a", "b", "b.1", "b.2", "b.3"}, true},
+		{"PT-1", nil, false},
+	}
+	for _, tt := range tests {
+		got, known := c.ControlParts(tt.control)
+		if known != tt.known || len(got) != len(tt.want) || (len(got) > 0 && !reflect.DeepEqual(got, tt.want)) {
+			t.Errorf("ControlParts(%s) = %v, %v, want %v, %v", tt.control, got, known, tt.want, tt.known)
+		}
+	}
+	if got := Parent("d.1"); got != "d" {
+		t.Errorf("Parent(d.1) = %q, want d", got)
+	}
+}
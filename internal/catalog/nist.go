@@ -0,0 +1,110 @@
+package catalog
+
+// nistTable lists the statement parts of NIST SP 800-53 Rev. 5 controls, one
+// control or enhancement per line followed by the narrative keys of its
+// parts. Controls without parts are listed alone. It only covers the
+// controls most commonly assessed for container platforms; the full
+// structure is loaded from the OSCAL catalog with Load.
+const nistTable = `
+AC-1        a a.1 a.2 b c c.1 c.2
+AC-2        a b c d d.1 d.2 d.3 e f g h h.1 h.2 h.3 i i.1 i.2 i.3 j k l
+AC-2 (1)
+AC-2 (2)
+AC-2 (3)    a b c d
+AC-2 (4)
+AC-2 (5)
+AC-2 (12)   a b
+AC-2 (13)
+AC-3
+AC-4
+AC-5        a b
+AC-6
+AC-6 (1)    a b
+AC-6 (2)
+AC-6 (5)
+AC-6 (7)    a b
+AC-6 (9)
+AC-6 (10)
+AC-7        a b
+AC-8        a a.1 a.2 a.3 a.4 b c c.1 c.2 c.3
+AC-11       a b
+AC-11 (1)
+AC-12
+AC-14       a b
+AC-17       a b
+AC-17 (1)
+AC-17 (2)
+AC-17 (3)
+AC-17 (4)   a b
+AC-18       a b
+AC-19       a b
+AC-20       a a.1 a.2 b
+AC-20 (1)   a b
+AC-20 (2)
+AC-21       a b
+AC-22       a b c d
+AU-1        a a.1 a.2 b c c.1 c.2
+AU-2        a b c d e
+AU-3        a b c d e f
+AU-3 (1)
+AU-4
+AU-5        a b
+AU-6        a b c
+AU-6 (1)
+AU-6 (3)
+AU-7        a b
+AU-7 (1)
+AU-8        a b
+AU-9        a b
+AU-9 (4)
+AU-11
+AU-12       a b c
+CM-1        a a.1 a.2 b c c.1 c.2
+CM-2        a b b.1 b.2 b.3
+CM-2 (2)
+CM-2 (3)
+CM-2 (7)    a b
+CM-3        a b c d e f g
+CM-4
+CM-5
+CM-6        a b c d
+CM-6 (1)
+CM-7        a b
+CM-7 (1)    a b
+CM-7 (2)
+CM-7 (5)    a b c
+CM-8        a a.1 a.2 a.3 a.4 a.5 b
+CM-8 (1)
+CM-8 (3)    a b
+CM-10       a b c
+CM-11       a b c
+IA-1        a a.1 a.2 b c c.1 c.2
+IA-2
+IA-2 (1)
+IA-2 (2)
+IA-2 (8)
+IA-2 (12)
+IA-4        a b c d
+IA-5        a b c d e f g h i
+IA-5 (1)    a b c d e f g h
+IA-8
+IA-11
+SC-1        a a.1 a.2 b c c.1 c.2
+SC-5        a b
+SC-7        a b c
+SC-8
+SC-8 (1)
+SC-12
+SC-13       a b
+SC-28
+SC-39
+SI-1        a a.1 a.2 b c c.1 c.2
+SI-2        a b c d
+SI-3        a b c c.1 c.2 d
+SI-4        a a.1 a.2 b c c.1 c.2 d e f g
+SI-5        a b c d
+SI-7        a b
+SI-10
+SI-11       a b
+SI-12
+`
@@ -0,0 +1,164 @@
+// Package content reads the rules of a ComplianceAsCode (SCAP Security Guide)
+// checkout and the NIST 800-53 controls they reference.
+package content
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/carlosmmatos/automate-compliance/internal/xccdf"
+)
+
+// RuleFile is the name of the files describing a rule, the id of the rule
+// being the name of the directory holding it.
+const RuleFile = "rule.yml"
+
+// Rule is a rule of the content.
+type Rule struct {
+	ID       string   `json:"id"`
+	Title    string   `json:"title"`
+	Severity string   `json:"severity,omitempty"`
+	Path     string   `json:"path"`
+	Controls []string `json:"-"`
+}
+
+// isJinja reports whether a line of a rule.yml file is a Jinja statement,
+// macro call or comment.
+func isJinja(line string) bool {
+	line = strings.TrimSpace(line)
+	for _, prefix := range []string{"{{%", "{{{", "{{#"} {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type yamlRule struct {
+	Title      string                 `yaml:"title"`
+	Severity   string                 `yaml:"severity"`
+	References map[string]interface{} `yaml:"references"`
+}
+
+// ParseRule parses a rule.yml file. Jinja statements, macro calls and
+// comments on lines of their own, such as "{{% if product == 'rhel8' %}}" or
+// "{{{ complete_ocil_entry_sshd_option(...) }}}", are dropped so the rest can
+// be read as YAML, which means references of every product are kept.
+func ParseRule(id, path string, b []byte) (Rule, []error, error) {
+	var lines []string
+	for _, line := range strings.Split(string(b), "\n") {
+		if isJinja(line) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	var yr yamlRule
+	if err := yaml.Unmarshal([]byte(strings.Join(lines, "\n")), &yr); err != nil {
+		return Rule{}, nil, err
+	}
+
+	rule := Rule{ID: id, Title: strings.TrimSpace(yr.Title), Severity: yr.Severity, Path: path}
+	var errs []error
+	seen := make(map[string]bool)
+	for _, name := range sortedKeys(yr.References) {
+		// nist@<product> holds product specific references, nist-csf is
+		// another framework
+		if name != "nist" && !strings.HasPrefix(name, "nist@") {
+			continue
+		}
+		for _, ref := range referenceValues(yr.References[name]) {
+			keys, refErrs := xccdf.ControlKeys(ref)
+			for _, err := range refErrs {
+				errs = append(errs, fmt.Errorf("rule %s: %v", id, err))
+			}
+			for _, k := range keys {
+				if !seen[k] {
+					seen[k] = true
+					rule.Controls = append(rule.Controls, k)
+				}
+			}
+		}
+	}
+	return rule, errs, nil
+}
+
+// referenceValues returns the references of a rule, written either as a
+// comma separated string or as a list.
+func referenceValues(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var refs []string
+		for _, r := range v {
+			refs = append(refs, fmt.Sprint(r))
+		}
+		return refs
+	default:
+		return nil
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Load reads every rule.yml under a directory, typically the root of a
+// ComplianceAsCode checkout, and returns the rules sorted by id. The rules
+// that can't be read and the references that can't be mapped to a control
+// are returned as warnings.
+func Load(dir string) ([]Rule, []error, error) {
+	var rules []Rule
+	var warnings []error
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			// build output and checkouts of other tools
+			if name := info.Name(); path != dir && (strings.HasPrefix(name, ".") || name == "build") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.Name() != RuleFile {
+			return nil
+		}
+
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rule, errs, err := ParseRule(filepath.Base(filepath.Dir(path)), path, b)
+		if err != nil {
+			warnings = append(warnings, fmt.Errorf("%s: %v", path, err))
+			return nil
+		}
+		warnings = append(warnings, errs...)
+		rules = append(rules, rule)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil, fmt.Errorf("no %s found under %s", RuleFile, dir)
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].ID < rules[j].ID
+	})
+	return rules, warnings, nil
+}
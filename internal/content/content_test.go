@@ -0,0 +1,149 @@
+package content
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const accountsTmout = `documentation_complete: true
+
+prodtype: fedora,rhel7,rhel8
+
+title: 'Set Interactive Session Timeout'
+
+description: |-
+    Setting the <tt>TMOUT</tt> option in <tt>/etc/profile</tt> ensures that
+    all user sessions will terminate based on inactivity.
+    {{{ weblink(link="https://example.com") }}}
+
+severity: medium
+
+references:
+    cis@rhel8: 5.5.5
+    nist: AC-12,SC-10,AC-2(5)
+{{% if product == "rhel8" %}}
+    nist@rhel8: CM-6(a)
+{{% endif %}}
+    nist-csf: PR.AC-7
+    srg: SRG-OS-000163-GPOS-00072
+`
+
+const sshdDisableRootLogin = `documentation_complete: true
+
+title: 'Disable SSH Root Login'
+
+{{# the ocil entries are generated by a macro #}}
+severity: high
+
+references:
+    nist: AC-6(2),AC-17(a),IA-2,CM-6(a)
+
+{{{ complete_ocil_entry_sshd_option(default="no", option="PermitRootLogin", value="no") }}}
+`
+
+func TestParseRule(t *testing.T) {
+	tests := []struct {
+		name         string
+		doc          string
+		want         Rule
+		wantWarnings int
+		wantErr      bool
+	}{
+		{
+			"References of every product are read",
+			accountsTmout,
+			Rule{
+				ID:       "accounts_tmout",
+				Title:    "Set Interactive Session Timeout",
+				Severity: "medium",
+				Path:     "rule.yml",
+				Controls: []string{"AC-12", "SC-10", "AC-2 (5)", "CM-6"},
+			},
+			0,
+			false,
+		},
+		{
+			"References may be lists",
+			"title: Audit logins\nreferences:\n    nist:\n        - AU-2(d)\n        - AU-12(c)\n        - Req-1\n",
+			Rule{ID: "accounts_tmout", Title: "Audit logins", Path: "rule.yml", Controls: []string{"AU-2", "AU-12"}},
+			1,
+			false,
+		},
+		{
+			"Macro calls and comments are dropped",
+			sshdDisableRootLogin,
+			Rule{ID: "accounts_tmout", Title: "Disable SSH Root Login", Severity: "high", Path: "rule.yml", Controls: []string{"AC-6 (2)", "AC-17", "IA-2", "CM-6"}},
+			0,
+			false,
+		},
+		{
+			"Rules without references have no controls",
+			"title: Install AIDE\n",
+			Rule{ID: "accounts_tmout", Title: "Install AIDE", Path: "rule.yml"},
+			0,
+			false,
+		},
+		{
+			"Invalid YAML returns an error",
+			"title: [",
+			Rule{},
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, warnings, err := ParseRule("accounts_tmout", "rule.yml", []byte(tt.doc))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRule() = %+v, want %+v", got, tt.want)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("ParseRule() warnings = %v, want %d", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"linux_os/guide/system/accounts/accounts_tmout/rule.yml":     accountsTmout,
+		"linux_os/guide/system/aide/package_aide_installed/rule.yml": "title: Install AIDE\nreferences:\n    nist: CM-6(a)\n",
+		"linux_os/guide/system/broken/rule.yml":                      "title: [",
+		"build/rhel8/rules/accounts_tmout/rule.yml":                  accountsTmout,
+	}
+	for name, doc := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(doc), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rules, warnings, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	var ids []string
+	for _, r := range rules {
+		ids = append(ids, r.ID)
+	}
+	if want := []string{"accounts_tmout", "package_aide_installed"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Load() rules = %v, want %v", ids, want)
+	}
+	if len(warnings) != 1 {
+		t.Errorf("Load() warnings = %v, want the broken rule", warnings)
+	}
+
+	if _, _, err := Load(t.TempDir()); err == nil {
+		t.Errorf("Load() of a directory without rules returned no error")
+	}
+}
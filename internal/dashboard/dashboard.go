@@ -0,0 +1,166 @@
+package dashboard
+
+import (
+	"html/template"
+	"io"
+	"sort"
+	"time"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+	"github.com/carlosmmatos/automate-compliance/internal/report"
+	"github.com/carlosmmatos/automate-compliance/internal/source"
+)
+
+// statuses is the order of the heatmap columns. Unknown statuses are counted
+// in the report.StatusOther column, as in the coverage report.
+var statuses = []string{
+	parser.StatusComplete,
+	parser.StatusPartial,
+	parser.StatusPlanned,
+	parser.StatusNone,
+	parser.StatusNotApplicable,
+	report.StatusUnset,
+	report.StatusOther,
+}
+
+// Control is a control as shown in the drill-down list.
+type Control struct {
+	Family      string
+	Key         string
+	Status      string
+	Origin      string
+	Owner       string
+	Narratives  []v3c.NarrativeSection
+	Evidence    []string
+	Diagnostics []parser.Diagnostic
+	// Column is the heatmap column of the status, used by the status filter
+	Column string
+}
+
+// Cell is a cell of the heatmap.
+type Cell struct {
+	Status string
+	Count  int
+	// Level goes from 0 (no controls) to 4 (all the controls of the family)
+	Level int
+}
+
+// Family is a row of the heatmap.
+type Family struct {
+	Name     string
+	Total    int
+	Complete float64
+	Cells    []Cell
+}
+
+// Page holds everything rendered in the dashboard.
+type Page struct {
+	Title       string
+	Generated   time.Time
+	Statuses    []string
+	Families    []Family
+	Total       report.FamilyCoverage
+	Controls    []Control
+	Owners      []string
+	Diagnostics []parser.Diagnostic
+}
+
+// NewPage builds the dashboard of a parsed spreadsheet.
+func NewPage(title string, res source.Result, generated time.Time) Page {
+	coverage := report.NewCoverage(res.Data)
+	p := Page{
+		Title:       title,
+		Generated:   generated,
+		Statuses:    statuses,
+		Total:       coverage.Total,
+		Diagnostics: res.Diagnostics,
+	}
+
+	for _, fc := range coverage.Families {
+		f := Family{Name: fc.Family, Total: fc.Controls + fc.Enhancements, Complete: fc.Complete}
+		for _, s := range statuses {
+			count := fc.Statuses[s]
+			if s == report.StatusOther {
+				count = fc.Other()
+			}
+			f.Cells = append(f.Cells, Cell{Status: s, Count: count, Level: level(count, f.Total)})
+		}
+		p.Families = append(p.Families, f)
+	}
+
+	// Diagnostics are attached to the controls whose rows they were found in
+	byLine := make(map[int][]parser.Diagnostic)
+	for _, d := range res.Diagnostics {
+		byLine[d.Line] = append(byLine[d.Line], d)
+	}
+
+	owners := make(map[string]bool)
+	for family, ctrls := range res.Data {
+		for key, ctrl := range ctrls {
+			details := res.Details[key]
+			c := Control{
+				Family:     string(family),
+				Key:        key,
+				Status:     ctrl.ImplementationStatus,
+				Origin:     ctrl.ControlOrigin,
+				Owner:      details.Owner,
+				Narratives: ctrl.Narrative,
+				Evidence:   details.Evidence,
+			}
+			if c.Status == "" {
+				c.Status = report.StatusUnset
+			}
+			c.Column = c.Status
+			if !contains(statuses, c.Status) {
+				c.Column = report.StatusOther
+			}
+			for _, line := range details.Lines {
+				c.Diagnostics = append(c.Diagnostics, byLine[line]...)
+			}
+			if c.Owner != "" {
+				owners[c.Owner] = true
+			}
+			p.Controls = append(p.Controls, c)
+		}
+	}
+	sort.Slice(p.Controls, func(i, j int) bool {
+		return sortorder.NaturalLess(p.Controls[i].Key, p.Controls[j].Key)
+	})
+	for o := range owners {
+		p.Owners = append(p.Owners, o)
+	}
+	sort.Strings(p.Owners)
+	return p
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func level(count, total int) int {
+	if count == 0 || total == 0 {
+		return 0
+	}
+	// 1 to 4, by quarters of the family
+	return 1 + (count*4-1)/total
+}
+
+// Write renders the dashboard as a single HTML file without external
+// resources.
+func (p Page) Write(w io.Writer) error {
+	return pageTemplate.Execute(w, p)
+}
+
+var pageTemplate = template.Must(template.New("dashboard").Funcs(template.FuncMap{
+	"placeholder": parser.IsPlaceholder,
+	"css":         func() template.CSS { return template.CSS(styles) },
+	"js":          func() template.JS { return template.JS(script) },
+}).Parse(page))
@@ -0,0 +1,104 @@
+package dashboard
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+	"github.com/carlosmmatos/automate-compliance/internal/source"
+)
+
+func testResult() source.Result {
+	return source.Result{
+		Data: parser.Data{
+			"AC-Access_Control": {
+				"AC-2": v3c.Satisfies{
+					ControlKey:           "AC-2",
+					ImplementationStatus: parser.StatusComplete,
+					ControlOrigin:        "shared",
+					Narrative:            []v3c.NarrativeSection{{Key: "a", Text: "Accounts <b>are</b> reviewed"}},
+				},
+				"AC-3": v3c.Satisfies{
+					ControlKey: "AC-3",
+					Narrative:  []v3c.NarrativeSection{{Text: parser.PlaceholderTextOnly}},
+				},
+				"AC-4": v3c.Satisfies{
+					ControlKey:           "AC-4",
+					ImplementationStatus: "in review",
+				},
+			},
+		},
+		Details: map[string]parser.Details{
+			"AC-2": {Owner: "jdoe", Evidence: []string{"https://evidence.example/ac-2", "javascript:alert(1)"}, Lines: []int{2}},
+			"AC-3": {Lines: []int{3}},
+		},
+		Diagnostics: []parser.Diagnostic{
+			{Line: 3, Control: "AC-3", Severity: parser.SeverityWarning, Message: "unknown implementation status"},
+		},
+	}
+}
+
+func TestNewPage(t *testing.T) {
+	p := NewPage("RHACM", testResult(), time.Now())
+
+	if len(p.Controls) != 3 || p.Controls[0].Key != "AC-2" || p.Controls[1].Status != "unset" {
+		t.Fatalf("NewPage() controls = %+v", p.Controls)
+	}
+	if len(p.Controls[1].Diagnostics) != 1 {
+		t.Errorf("NewPage() didn't attach the warning of row 3 to AC-3: %+v", p.Controls[1])
+	}
+	if len(p.Families) != 1 || p.Families[0].Cells[0].Count != 1 || p.Families[0].Cells[0].Level != 2 {
+		t.Errorf("NewPage() families = %+v", p.Families)
+	}
+	// statuses without column are counted and filtered as other
+	other := p.Families[0].Cells[len(statuses)-1]
+	if other.Status != "other" || other.Count != 1 || p.Controls[2].Status != "in review" || p.Controls[2].Column != "other" {
+		t.Errorf("NewPage() other column = %+v, control %+v", other, p.Controls[2])
+	}
+}
+
+func TestPage_Write(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewPage("RHACM", testResult(), time.Now()).Write(&buf); err != nil {
+		t.Fatalf("Page.Write() error = %v", err)
+	}
+	html := buf.String()
+
+	for _, want := range []string{
+		"<title>RHACM</title>",
+		`href="https://evidence.example/ac-2"`,
+		"Accounts &lt;b&gt;are&lt;/b&gt; reviewed",
+		`class="narrative placeholder"`,
+		"Row 3: unknown implementation status",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Page.Write() output doesn't contain %q", want)
+		}
+	}
+	for _, unwanted := range []string{`href="javascript:`, "<script src", "<link"} {
+		if strings.Contains(html, unwanted) {
+			t.Errorf("Page.Write() output contains %q", unwanted)
+		}
+	}
+}
+
+func Test_level(t *testing.T) {
+	tests := []struct {
+		count, total, want int
+	}{
+		{0, 10, 0},
+		{1, 10, 1},
+		{3, 10, 2},
+		{10, 10, 4},
+		{0, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := level(tt.count, tt.total); got != tt.want {
+			t.Errorf("level(%d, %d) = %d, want %d", tt.count, tt.total, got, tt.want)
+		}
+	}
+}
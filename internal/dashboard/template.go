@@ -0,0 +1,169 @@
+package dashboard
+
+// The dashboard is a single file meant to be shared by email or attached to
+// tickets, so styles and scripts are inlined rather than loaded from a CDN.
+
+const page = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>{{css}}</style>
+</head>
+<body>
+<header>
+  <h1>{{.Title}}</h1>
+  <p class="meta">Generated {{.Generated.Format "2006-01-02 15:04 MST"}} &middot;
+    {{.Total.Controls}} controls, {{.Total.Enhancements}} enhancements &middot;
+    {{printf "%.1f" .Total.Complete}}% complete &middot;
+    {{printf "%.1f" .Total.NarrativeCompleteness}}% of narratives written</p>
+</header>
+
+<section>
+  <h2>Status by family</h2>
+  <table class="heatmap">
+    <thead>
+      <tr><th>Family</th>{{range .Statuses}}<th>{{.}}</th>{{end}}<th>Complete</th></tr>
+    </thead>
+    <tbody>
+    {{range .Families}}{{$family := .Name}}
+      <tr>
+        <th><a href="#controls" data-family="{{.Name}}">{{.Name}}</a></th>
+        {{range .Cells}}<td class="level{{.Level}} {{.Status}}"><a href="#controls" data-family="{{$family}}" data-status="{{.Status}}">{{.Count}}</a></td>{{end}}
+        <td>{{printf "%.1f" .Complete}}%</td>
+      </tr>
+    {{end}}
+    </tbody>
+  </table>
+</section>
+
+<section id="controls">
+  <h2>Controls</h2>
+  <form class="filters" onsubmit="return false">
+    <input id="search" type="search" placeholder="Search controls and narratives">
+    <select id="family"><option value="">All families</option>{{range .Families}}<option>{{.Name}}</option>{{end}}</select>
+    <select id="status"><option value="">All statuses</option>{{range .Statuses}}<option>{{.}}</option>{{end}}</select>
+    <select id="owner"><option value="">All owners</option>{{range .Owners}}<option>{{.}}</option>{{end}}</select>
+    <label><input id="warnings" type="checkbox"> Only with warnings</label>
+    <span id="count"></span>
+  </form>
+  {{range .Controls}}
+  <details class="control" data-family="{{.Family}}" data-status="{{.Column}}" data-owner="{{.Owner}}" data-warnings="{{len .Diagnostics}}">
+    <summary>
+      <span class="key">{{.Key}}</span>
+      <span class="badge {{.Status}}">{{.Status}}</span>
+      {{if .Owner}}<span class="owner">{{.Owner}}</span>{{end}}
+      {{if .Diagnostics}}<span class="warning">{{len .Diagnostics}} warnings</span>{{end}}
+    </summary>
+    <dl>
+      <dt>Family</dt><dd>{{.Family}}</dd>
+      {{if .Origin}}<dt>Origin</dt><dd>{{.Origin}}</dd>{{end}}
+      {{if .Owner}}<dt>Owner</dt><dd>{{.Owner}}</dd>{{end}}
+      {{if .Evidence}}<dt>Evidence</dt><dd><ul>{{range .Evidence}}<li><a href="{{.}}">{{.}}</a></li>{{end}}</ul></dd>{{end}}
+    </dl>
+    {{range .Narratives}}
+    <div class="narrative{{if placeholder .Text}} placeholder{{end}}">
+      {{if .Key}}<span class="narrative-key">{{.Key}}</span>{{end}}
+      <p>{{.Text}}</p>
+    </div>
+    {{end}}
+    {{if .Diagnostics}}<ul class="diagnostics">{{range .Diagnostics}}<li class="{{.Severity}}">Row {{.Line}}: {{.Message}}</li>{{end}}</ul>{{end}}
+  </details>
+  {{end}}
+</section>
+
+{{if .Diagnostics}}
+<section>
+  <h2>Parse warnings</h2>
+  <ul class="diagnostics">
+  {{range .Diagnostics}}<li class="{{.Severity}}">Row {{.Line}} ({{.Control}}): {{.Message}}</li>{{end}}
+  </ul>
+</section>
+{{end}}
+
+<script>{{js}}</script>
+</body>
+</html>
+`
+
+const styles = `
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 0 auto; max-width: 1100px; padding: 0 1em 3em; color: #222; }
+h1 { margin-bottom: 0; }
+.meta { color: #666; }
+a { color: inherit; }
+table.heatmap { border-collapse: collapse; width: 100%; font-size: 0.9em; }
+.heatmap th, .heatmap td { border: 1px solid #ddd; padding: 0.3em 0.5em; text-align: center; }
+.heatmap th:first-child { text-align: left; }
+.heatmap td a { display: block; text-decoration: none; }
+.level0 { background: #fff; color: #bbb; }
+.level1 { background: #e3edf7; }
+.level2 { background: #b8d0ea; }
+.level3 { background: #7fa9d8; }
+.level4 { background: #3d7cc2; color: #fff; }
+.filters { display: flex; flex-wrap: wrap; gap: 0.5em; align-items: center; margin-bottom: 1em; }
+.filters input[type=search] { flex: 1; min-width: 15em; padding: 0.3em; }
+#count { color: #666; }
+details.control { border: 1px solid #ddd; border-radius: 4px; margin: 0.3em 0; padding: 0.3em 0.6em; }
+details.control summary { cursor: pointer; }
+.key { font-weight: bold; margin-right: 0.5em; }
+.badge { border-radius: 3px; padding: 0 0.4em; font-size: 0.85em; background: #eee; }
+.badge.complete { background: #c9ecc9; }
+.badge.partial { background: #fbe6a8; }
+.badge.planned { background: #d9e4f5; }
+.badge.none { background: #f5c6c6; }
+.owner { color: #555; margin-left: 0.5em; font-size: 0.9em; }
+.warning, .diagnostics .warning { color: #9a6700; }
+.diagnostics .error { color: #b00020; }
+dl { display: grid; grid-template-columns: max-content auto; gap: 0.2em 1em; font-size: 0.9em; }
+dt { color: #666; }
+dd { margin: 0; }
+dd ul { margin: 0; padding-left: 1.2em; }
+.narrative { border-left: 3px solid #3d7cc2; padding-left: 0.6em; margin: 0.5em 0; }
+.narrative.placeholder { border-color: #f0a500; color: #888; font-style: italic; }
+.narrative p { margin: 0.2em 0; white-space: pre-wrap; }
+.narrative-key { font-weight: bold; }
+`
+
+const script = `
+(function() {
+  var controls = Array.prototype.slice.call(document.querySelectorAll('details.control'));
+  var search = document.getElementById('search');
+  var family = document.getElementById('family');
+  var status = document.getElementById('status');
+  var owner = document.getElementById('owner');
+  var warnings = document.getElementById('warnings');
+  var count = document.getElementById('count');
+
+  function apply() {
+    var q = search.value.toLowerCase();
+    var shown = 0;
+    controls.forEach(function(c) {
+      var visible = (!family.value || c.dataset.family === family.value) &&
+        (!status.value || c.dataset.status === status.value) &&
+        (!owner.value || c.dataset.owner === owner.value) &&
+        (!warnings.checked || c.dataset.warnings !== '0') &&
+        (!q || c.textContent.toLowerCase().indexOf(q) !== -1);
+      c.style.display = visible ? '' : 'none';
+      if (visible) { shown++; }
+    });
+    count.textContent = shown + ' of ' + controls.length + ' controls';
+  }
+
+  [search, family, status, owner, warnings].forEach(function(el) {
+    el.addEventListener('input', apply);
+    el.addEventListener('change', apply);
+  });
+
+  // Clicking on the heatmap filters the list of controls
+  document.querySelectorAll('.heatmap a').forEach(function(a) {
+    a.addEventListener('click', function() {
+      family.value = a.dataset.family || '';
+      status.value = a.dataset.status || '';
+      apply();
+    });
+  });
+
+  apply();
+})();
+`
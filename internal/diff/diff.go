@@ -0,0 +1,153 @@
+package diff
+
+import (
+	"sort"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+	"vbom.ml/util/sortorder"
+)
+
+// Kinds of changes between two assessments.
+const (
+	ControlAdded     = "control_added"
+	ControlRemoved   = "control_removed"
+	StatusChanged    = "status_changed"
+	NarrativeAdded   = "narrative_added"
+	NarrativeRemoved = "narrative_removed"
+	NarrativeChanged = "narrative_changed"
+)
+
+// Change is a single difference between two assessments. Old and New hold
+// the status or narrative text, depending on the kind of change.
+type Change struct {
+	Kind         string `json:"kind"`
+	ControlKey   string `json:"control_key"`
+	NarrativeKey string `json:"narrative_key,omitempty"`
+	Old          string `json:"old,omitempty"`
+	New          string `json:"new,omitempty"`
+}
+
+// Report holds all the changes between two assessments.
+type Report struct {
+	Old     string         `json:"old"`
+	New     string         `json:"new"`
+	Summary map[string]int `json:"summary"`
+	Changes []Change       `json:"changes"`
+}
+
+// NewReport compares two assessments, labelled after their source.
+func NewReport(oldLabel, newLabel string, old, new []v3c.Satisfies) Report {
+	r := Report{
+		Old:     oldLabel,
+		New:     newLabel,
+		Summary: make(map[string]int),
+		Changes: Compare(old, new),
+	}
+	for _, c := range r.Changes {
+		r.Summary[c.Kind]++
+	}
+	return r
+}
+
+// Compare returns the changes needed to go from the old controls to the new
+// ones, sorted by control key and narrative key.
+func Compare(old, new []v3c.Satisfies) []Change {
+	oldCtrls := index(old)
+	newCtrls := index(new)
+
+	changes := []Change{}
+	for _, key := range controlKeys(oldCtrls, newCtrls) {
+		o, inOld := oldCtrls[key]
+		n, inNew := newCtrls[key]
+
+		switch {
+		case !inOld:
+			changes = append(changes, Change{Kind: ControlAdded, ControlKey: key, New: n.ImplementationStatus})
+		case !inNew:
+			changes = append(changes, Change{Kind: ControlRemoved, ControlKey: key, Old: o.ImplementationStatus})
+		default:
+			changes = append(changes, compareControl(o, n)...)
+		}
+	}
+	return changes
+}
+
+func compareControl(o, n v3c.Satisfies) []Change {
+	var changes []Change
+	if o.ImplementationStatus != n.ImplementationStatus {
+		changes = append(changes, Change{
+			Kind:       StatusChanged,
+			ControlKey: o.ControlKey,
+			Old:        o.ImplementationStatus,
+			New:        n.ImplementationStatus,
+		})
+	}
+
+	oldNarr := make(map[string]string)
+	for _, s := range o.Narrative {
+		oldNarr[s.Key] = s.Text
+	}
+	newNarr := make(map[string]string)
+	for _, s := range n.Narrative {
+		newNarr[s.Key] = s.Text
+	}
+
+	for _, key := range narrativeKeys(oldNarr, newNarr) {
+		oldText, inOld := oldNarr[key]
+		newText, inNew := newNarr[key]
+		c := Change{ControlKey: o.ControlKey, NarrativeKey: key, Old: oldText, New: newText}
+		switch {
+		case !inOld:
+			c.Kind = NarrativeAdded
+		case !inNew:
+			c.Kind = NarrativeRemoved
+		case oldText != newText:
+			c.Kind = NarrativeChanged
+		default:
+			continue
+		}
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+func index(s []v3c.Satisfies) map[string]v3c.Satisfies {
+	idx := make(map[string]v3c.Satisfies, len(s))
+	for _, ctrl := range s {
+		idx[ctrl.ControlKey] = ctrl
+	}
+	return idx
+}
+
+func controlKeys(a, b map[string]v3c.Satisfies) []string {
+	set := make(map[string]bool)
+	for k := range a {
+		set[k] = true
+	}
+	for k := range b {
+		set[k] = true
+	}
+	return sortedKeys(set)
+}
+
+func narrativeKeys(a, b map[string]string) []string {
+	set := make(map[string]bool)
+	for k := range a {
+		set[k] = true
+	}
+	for k := range b {
+		set[k] = true
+	}
+	return sortedKeys(set)
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return sortorder.NaturalLess(keys[i], keys[j])
+	})
+	return keys
+}
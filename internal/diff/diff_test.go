@@ -0,0 +1,90 @@
+package diff
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+)
+
+func buildControl(key, status string, n ...v3c.NarrativeSection) v3c.Satisfies {
+	return v3c.Satisfies{
+		ControlKey:           key,
+		ImplementationStatus: status,
+		Narrative:            n,
+	}
+}
+
+func narrative(key, text string) v3c.NarrativeSection {
+	return v3c.NarrativeSection{Key: key, Text: text}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		old  []v3c.Satisfies
+		new  []v3c.Satisfies
+		want []Change
+	}{
+		{
+			"Identical assessments have no changes",
+			[]v3c.Satisfies{buildControl("AC-2", "complete", narrative("a", "text"))},
+			[]v3c.Satisfies{buildControl("AC-2", "complete", narrative("a", "text"))},
+			[]Change{},
+		},
+		{
+			"Added and removed controls are reported in natural order",
+			[]v3c.Satisfies{buildControl("AC-10", "planned")},
+			[]v3c.Satisfies{buildControl("AC-2 (1)", ""), buildControl("AC-2", "")},
+			[]Change{
+				{Kind: ControlAdded, ControlKey: "AC-2"},
+				{Kind: ControlAdded, ControlKey: "AC-2 (1)"},
+				{Kind: ControlRemoved, ControlKey: "AC-10", Old: "planned"},
+			},
+		},
+		{
+			"Status and narrative changes are reported",
+			[]v3c.Satisfies{buildControl("AC-2", "planned", narrative("a", "old"), narrative("b", "b"))},
+			[]v3c.Satisfies{buildControl("AC-2", "complete", narrative("a", "new"), narrative("b.1", "b.1"))},
+			[]Change{
+				{Kind: StatusChanged, ControlKey: "AC-2", Old: "planned", New: "complete"},
+				{Kind: NarrativeChanged, ControlKey: "AC-2", NarrativeKey: "a", Old: "old", New: "new"},
+				{Kind: NarrativeRemoved, ControlKey: "AC-2", NarrativeKey: "b", Old: "b"},
+				{Kind: NarrativeAdded, ControlKey: "AC-2", NarrativeKey: "b.1", New: "b.1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compare(tt.old, tt.new); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReport_WriteText(t *testing.T) {
+	r := NewReport("old.csv", "new.csv",
+		[]v3c.Satisfies{buildControl("AC-2", "planned", narrative("a", "old"))},
+		[]v3c.Satisfies{buildControl("AC-2", "complete", narrative("a", "new")), buildControl("AC-3", "")},
+	)
+
+	var buf bytes.Buffer
+	if err := r.WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `--- old.csv
++++ new.csv
+~ AC-2: status planned -> complete
+~ AC-2 (a): narrative changed
+    - old
+    + new
++ AC-3: control added
+
+1 controls added, 0 removed, 1 status changes, 0 narratives added, 0 removed, 1 changed
+`
+	if buf.String() != want {
+		t.Errorf("Report.WriteText() =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
@@ -0,0 +1,75 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteText writes a human readable version of the report.
+func (r Report) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "--- %s\n+++ %s\n", r.Old, r.New)
+	if len(r.Changes) == 0 {
+		_, err := fmt.Fprintln(w, "No changes")
+		return err
+	}
+
+	for _, c := range r.Changes {
+		key := c.ControlKey
+		if c.NarrativeKey != "" {
+			key = fmt.Sprintf("%s (%s)", key, c.NarrativeKey)
+		}
+
+		switch c.Kind {
+		case ControlAdded:
+			fmt.Fprintf(w, "+ %s: control added%s\n", key, statusSuffix(c.New))
+		case ControlRemoved:
+			fmt.Fprintf(w, "- %s: control removed%s\n", key, statusSuffix(c.Old))
+		case StatusChanged:
+			fmt.Fprintf(w, "~ %s: status %s -> %s\n", key, orUnset(c.Old), orUnset(c.New))
+		case NarrativeAdded:
+			fmt.Fprintf(w, "+ %s: narrative added\n", key)
+			writeText(w, "+", c.New)
+		case NarrativeRemoved:
+			fmt.Fprintf(w, "- %s: narrative removed\n", key)
+			writeText(w, "-", c.Old)
+		case NarrativeChanged:
+			fmt.Fprintf(w, "~ %s: narrative changed\n", key)
+			writeText(w, "-", c.Old)
+			writeText(w, "+", c.New)
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d controls added, %d removed, %d status changes, %d narratives added, %d removed, %d changed\n",
+		r.Summary[ControlAdded], r.Summary[ControlRemoved], r.Summary[StatusChanged],
+		r.Summary[NarrativeAdded], r.Summary[NarrativeRemoved], r.Summary[NarrativeChanged])
+	return err
+}
+
+// WriteJSON writes the report as JSON.
+func (r Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+func writeText(w io.Writer, prefix, text string) {
+	for _, line := range strings.Split(text, "\n") {
+		fmt.Fprintf(w, "    %s %s\n", prefix, line)
+	}
+}
+
+func statusSuffix(status string) string {
+	if status == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", status)
+}
+
+func orUnset(status string) string {
+	if status == "" {
+		return "(unset)"
+	}
+	return status
+}
@@ -0,0 +1,168 @@
+package docs
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/opencontrol"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// Component is a named set of parsed controls to document.
+type Component struct {
+	Name string
+	Data parser.Data
+}
+
+// Render returns the Markdown pages documenting the components, keyed by
+// their path relative to the root of the book. Each component gets a
+// directory with a README.md and one page per family, and SUMMARY.md holds
+// the table of contents read by GitBook. The directories are named after the
+// slug of the components, so two components can't have the same slug.
+func Render(components []Component) (map[string]string, error) {
+	pages := make(map[string]string)
+	names := make(map[string]string)
+
+	var summary, readme bytes.Buffer
+	summary.WriteString("# Summary\n\n* [Introduction](README.md)\n")
+	readme.WriteString("# Components\n\n")
+
+	for _, c := range components {
+		dir := Slug(c.Name)
+		if dir == "" {
+			return nil, fmt.Errorf("component %q has no letters or digits to name its directory", c.Name)
+		}
+		if other, ok := names[dir]; ok {
+			return nil, fmt.Errorf("components %q and %q would both be written to %s", other, c.Name, dir)
+		}
+		names[dir] = c.Name
+		summary.WriteString(fmt.Sprintf("* [%s](%s/README.md)\n", c.Name, dir))
+		readme.WriteString(fmt.Sprintf("* [%s](%s/README.md)\n", c.Name, dir))
+
+		var index bytes.Buffer
+		index.WriteString(fmt.Sprintf("# %s\n\n", c.Name))
+		for _, family := range sortedFamilies(c.Data) {
+			file := familyFile(family)
+			title := FamilyTitle(family)
+			summary.WriteString(fmt.Sprintf("  * [%s](%s/%s)\n", title, dir, file))
+			index.WriteString(fmt.Sprintf("* [%s](%s)\n", title, file))
+			pages[filepath.Join(dir, file)] = renderFamily(family, c.Data[family])
+		}
+		pages[filepath.Join(dir, "README.md")] = index.String()
+	}
+
+	pages["SUMMARY.md"] = summary.String()
+	pages["README.md"] = readme.String()
+	return pages, nil
+}
+
+// Write renders the components and writes the pages under dir.
+func Write(dir string, components []Component) error {
+	pages, err := Render(components)
+	if err != nil {
+		return err
+	}
+	for path, content := range pages {
+		full := filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(full, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func renderFamily(family parser.ControlFamily, ctrls map[string]v3c.Satisfies) string {
+	var b bytes.Buffer
+	b.WriteString(fmt.Sprintf("# %s\n", FamilyTitle(family)))
+
+	var keys []string
+	for k := range ctrls {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return sortorder.NaturalLess(keys[i], keys[j])
+	})
+
+	for _, k := range keys {
+		ctrl := ctrls[k]
+		b.WriteString(fmt.Sprintf("\n## %s\n", k))
+
+		var meta []string
+		if ctrl.ImplementationStatus != "" {
+			meta = append(meta, fmt.Sprintf("**Status:** %s", ctrl.ImplementationStatus))
+		}
+		if ctrl.ControlOrigin != "" {
+			meta = append(meta, fmt.Sprintf("**Origin:** %s", ctrl.ControlOrigin))
+		}
+		if len(meta) > 0 {
+			b.WriteString("\n" + strings.Join(meta, " | ") + "\n")
+		}
+
+		narratives := append([]v3c.NarrativeSection(nil), ctrl.Narrative...)
+		opencontrol.SortNarratives(narratives)
+		for _, n := range narratives {
+			if n.Key != "" {
+				b.WriteString(fmt.Sprintf("\n### %s\n", n.Key))
+			}
+			b.WriteString("\n" + strings.TrimSpace(n.Text) + "\n")
+		}
+	}
+	return b.String()
+}
+
+func sortedFamilies(data parser.Data) []parser.ControlFamily {
+	var families []parser.ControlFamily
+	for f := range data {
+		families = append(families, f)
+	}
+	sort.Slice(families, func(i, j int) bool {
+		return families[i] < families[j]
+	})
+	return families
+}
+
+// FamilyTitle returns a readable title for a family, e.g. "AC - Access
+// Control" for "AC-Access_Control".
+func FamilyTitle(family parser.ControlFamily) string {
+	if family == "" {
+		return "Unknown family"
+	}
+	return strings.Replace(strings.Replace(string(family), "-", " - ", 1), "_", " ", -1)
+}
+
+// familyFile returns the name of the page of a family, unknown.md for the
+// controls whose family couldn't be parsed.
+func familyFile(family parser.ControlFamily) string {
+	if family == "" {
+		return "unknown.md"
+	}
+	return string(family) + ".md"
+}
+
+// Slug returns a directory friendly version of a name, empty when the name
+// has no letters or digits.
+func Slug(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteRune('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
@@ -0,0 +1,164 @@
+package docs
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+func testComponent() Component {
+	return Component{
+		Name: "Example Product",
+		Data: parser.Data{
+			"AC-Access_Control": {
+				"AC-3 (3)": {
+					ControlKey: "AC-3 (3)",
+					Narrative: []v3c.NarrativeSection{
+						{Key: "b.2", Text: "Second"},
+						{Key: "b", Text: "B"},
+						{Key: "a", Text: "A"},
+						{Key: "b.1", Text: "First"},
+					},
+				},
+				"AC-2": {
+					ControlKey:           "AC-2",
+					ImplementationStatus: parser.StatusComplete,
+					ControlOrigin:        "shared",
+					Narrative:            []v3c.NarrativeSection{{Text: "Accounts are reviewed"}},
+				},
+			},
+		},
+	}
+}
+
+func TestRender(t *testing.T) {
+	pages, err := Render([]Component{testComponent()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	for p := range pages {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	wantPaths := []string{
+		"README.md",
+		"SUMMARY.md",
+		"example-product/AC-Access_Control.md",
+		"example-product/README.md",
+	}
+	if !reflect.DeepEqual(paths, wantPaths) {
+		t.Fatalf("got pages %v, want %v", paths, wantPaths)
+	}
+
+	wantSummary := "# Summary\n\n" +
+		"* [Introduction](README.md)\n" +
+		"* [Example Product](example-product/README.md)\n" +
+		"  * [AC - Access Control](example-product/AC-Access_Control.md)\n"
+	if got := pages["SUMMARY.md"]; got != wantSummary {
+		t.Errorf("got summary:\n%s\nwant:\n%s", got, wantSummary)
+	}
+
+	wantFamily := "# AC - Access Control\n" +
+		"\n## AC-2\n" +
+		"\n**Status:** complete | **Origin:** shared\n" +
+		"\nAccounts are reviewed\n" +
+		"\n## AC-3 (3)\n" +
+		"\n### a\n\nA\n" +
+		"\n### b\n\nB\n" +
+		"\n### b.1\n\nFirst\n" +
+		"\n### b.2\n\nSecond\n"
+	if got := pages["example-product/AC-Access_Control.md"]; got != wantFamily {
+		t.Errorf("got family page:\n%s\nwant:\n%s", got, wantFamily)
+	}
+}
+
+func TestRender_UnknownFamily(t *testing.T) {
+	c := Component{Name: "RHACM", Data: parser.Data{"": {"XX-1": {ControlKey: "XX-1"}}}}
+	pages, err := Render([]Component{c})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := pages["rhacm/unknown.md"], "# Unknown family\n\n## XX-1\n"; got != want {
+		t.Errorf("got unknown family page %q, want %q", got, want)
+	}
+	if got, want := pages["rhacm/README.md"], "# RHACM\n\n* [Unknown family](unknown.md)\n"; got != want {
+		t.Errorf("got index %q, want %q", got, want)
+	}
+}
+
+func TestRender_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		names []string
+	}{
+		{"empty slug", []string{"RHACM", "--"}},
+		{"duplicate slug", []string{"Example Product", "example product"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var components []Component
+			for _, name := range tt.names {
+				components = append(components, Component{Name: name})
+			}
+			if _, err := Render(components); err == nil {
+				t.Errorf("Render(%q) succeeded, want an error", tt.names)
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	dir := t.TempDir()
+	if err := Write(dir, []Component{testComponent()}); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "example-product", "README.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "# Example Product\n\n* [AC - Access Control](AC-Access_Control.md)\n"
+	if string(b) != want {
+		t.Errorf("got %q, want %q", b, want)
+	}
+}
+
+func TestFamilyTitle(t *testing.T) {
+	tests := []struct {
+		family parser.ControlFamily
+		want   string
+	}{
+		{"AC-Access_Control", "AC - Access Control"},
+		{"PE-Physical_and_Environmental_Protection", "PE - Physical and Environmental Protection"},
+		{"", "Unknown family"},
+	}
+	for _, tt := range tests {
+		if got := FamilyTitle(tt.family); got != tt.want {
+			t.Errorf("FamilyTitle(%q) = %q, want %q", tt.family, got, tt.want)
+		}
+	}
+}
+
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"RHACM", "rhacm"},
+		{"Example Product", "example-product"},
+		{"  OpenShift / 4.x ", "openshift-4-x"},
+		{"--", ""},
+	}
+	for _, tt := range tests {
+		if got := Slug(tt.name); got != tt.want {
+			t.Errorf("Slug(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package history
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// timeFormat is used to name the snapshot files, so they sort by time.
+const timeFormat = "20060102T150405.000000000Z"
+
+// ControlStatus is the status of a control at the time of a snapshot.
+type ControlStatus struct {
+	Family     string `json:"family"`
+	ControlKey string `json:"control_key"`
+	Status     string `json:"status"`
+	// Narrative is a hash of the narratives, to tell when they changed
+	Narrative string `json:"narrative,omitempty"`
+}
+
+// Snapshot is the parsed result of a single run.
+type Snapshot struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Source is where the data was read from, e.g. the spreadsheet ID
+	Source string `json:"source"`
+	// Revision identifies the content of the source at the time of the run
+	Revision string          `json:"revision"`
+	Controls []ControlStatus `json:"controls"`
+	// Errors are the rows that couldn't be parsed
+	Errors []parser.Diagnostic `json:"errors,omitempty"`
+}
+
+// NewSnapshot builds a snapshot out of the parsed data.
+func NewSnapshot(ts time.Time, source, revision string, data parser.Data) Snapshot {
+	s := Snapshot{
+		Timestamp: ts.UTC(),
+		Source:    source,
+		Revision:  revision,
+		Controls:  []ControlStatus{},
+	}
+	for family, ctrls := range data {
+		for key, ctrl := range ctrls {
+			s.Controls = append(s.Controls, ControlStatus{
+				Family:     string(family),
+				ControlKey: key,
+				Status:     ctrl.ImplementationStatus,
+				Narrative:  narrativeHash(ctrl.Narrative),
+			})
+		}
+	}
+	sort.Slice(s.Controls, func(i, j int) bool {
+		return sortorder.NaturalLess(s.Controls[i].ControlKey, s.Controls[j].ControlKey)
+	})
+	return s
+}
+
+// narrativeHash returns a short hash of narratives, regardless of the order
+// of the rows they were read from, or "" when there are none.
+func narrativeHash(narratives []v3c.NarrativeSection) string {
+	if len(narratives) == 0 {
+		return ""
+	}
+	sorted := append([]v3c.NarrativeSection(nil), narratives...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Key < sorted[j].Key
+	})
+	h := sha256.New()
+	for _, n := range sorted {
+		fmt.Fprintf(h, "%q %q\n", n.Key, n.Text)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12]
+}
+
+// NarrativeChanged returns, for every control of the last snapshot, the time
+// of the first snapshot since which its narratives haven't changed.
+// Snapshots taken before narratives were recorded count as changes.
+func NarrativeChanged(snaps []Snapshot) map[string]time.Time {
+	changed := make(map[string]time.Time)
+	if len(snaps) == 0 {
+		return changed
+	}
+	last := snaps[len(snaps)-1]
+	// running holds the hash of the controls unchanged so far
+	running := make(map[string]string)
+	for _, c := range last.Controls {
+		if c.Narrative != "" {
+			changed[c.ControlKey] = last.Timestamp
+			running[c.ControlKey] = c.Narrative
+		}
+	}
+	for i := len(snaps) - 2; i >= 0 && len(running) > 0; i-- {
+		hashes := make(map[string]string, len(snaps[i].Controls))
+		for _, c := range snaps[i].Controls {
+			hashes[c.ControlKey] = c.Narrative
+		}
+		for key, hash := range running {
+			if hashes[key] == hash {
+				changed[key] = snaps[i].Timestamp
+			} else {
+				delete(running, key)
+			}
+		}
+	}
+	return changed
+}
+
+// Store keeps snapshots as JSON files in a local directory.
+type Store struct {
+	dir string
+}
+
+// NewStore returns a store using the given directory, which is created on
+// the first save.
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Save persists a snapshot and returns the path of its file.
+func (s *Store) Save(snap Snapshot) (string, error) {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(s.dir, snap.Timestamp.UTC().Format(timeFormat)+".json")
+	return path, ioutil.WriteFile(path, b, 0644)
+}
+
+// Load returns all the stored snapshots, oldest first. A missing directory
+// means there is no history yet.
+func (s *Store) Load() ([]Snapshot, error) {
+	files, err := ioutil.ReadDir(s.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var snaps []Snapshot
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+		snap, err := LoadSnapshot(filepath.Join(s.dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		snaps = append(snaps, snap)
+	}
+	sort.SliceStable(snaps, func(i, j int) bool {
+		return snaps[i].Timestamp.Before(snaps[j].Timestamp)
+	})
+	return snaps, nil
+}
+
+// LoadSource returns the stored snapshots of a source, oldest first.
+func (s *Store) LoadSource(source string) ([]Snapshot, error) {
+	snaps, err := s.Load()
+	if err != nil {
+		return nil, err
+	}
+	var filtered []Snapshot
+	for _, snap := range snaps {
+		if snap.Source == source {
+			filtered = append(filtered, snap)
+		}
+	}
+	return filtered, nil
+}
+
+// Last returns the most recent snapshot of a source, or nil if there is none.
+func (s *Store) Last(source string) (*Snapshot, error) {
+	snaps, err := s.LoadSource(source)
+	if err != nil || len(snaps) == 0 {
+		return nil, err
+	}
+	return &snaps[len(snaps)-1], nil
+}
+
+// LoadSnapshot reads a single snapshot file.
+func LoadSnapshot(path string) (Snapshot, error) {
+	var snap Snapshot
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return snap, err
+	}
+	err = json.Unmarshal(b, &snap)
+	return snap, err
+}
@@ -0,0 +1,214 @@
+package history
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+func snapshot(ts string, controls ...ControlStatus) Snapshot {
+	t, _ := time.Parse(time.RFC3339, ts)
+	return Snapshot{Timestamp: t, Source: "sheet", Revision: ts, Controls: controls}
+}
+
+func TestStore(t *testing.T) {
+	s := NewStore(t.TempDir())
+
+	data := parser.Data{
+		"AC-Access_Control": {
+			"AC-2":  v3c.Satisfies{ControlKey: "AC-2", ImplementationStatus: "complete"},
+			"AC-10": v3c.Satisfies{ControlKey: "AC-10"},
+		},
+	}
+	later := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	earlier := later.Add(-24 * time.Hour)
+	for _, ts := range []time.Time{later, earlier} {
+		if _, err := s.Save(NewSnapshot(ts, "sheet", "abc", data)); err != nil {
+			t.Fatalf("Store.Save() error = %v", err)
+		}
+	}
+
+	got, err := s.Load()
+	if err != nil {
+		t.Fatalf("Store.Load() error = %v", err)
+	}
+	want := []Snapshot{
+		{Timestamp: earlier, Source: "sheet", Revision: "abc", Controls: []ControlStatus{
+			{Family: "AC-Access_Control", ControlKey: "AC-2", Status: "complete"},
+			{Family: "AC-Access_Control", ControlKey: "AC-10"},
+		}},
+	}
+	want = append(want, want[0])
+	want[1].Timestamp = later
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Store.Load() = %v, want %v", got, want)
+	}
+}
+
+func TestStore_Last(t *testing.T) {
+	s := NewStore(t.TempDir())
+	base := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	for i, source := range []string{"a.csv", "b.csv", "a.csv", "b.csv"} {
+		snap := NewSnapshot(base.Add(time.Duration(i)*time.Hour), source, "", nil)
+		if _, err := s.Save(snap); err != nil {
+			t.Fatalf("Store.Save() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		source string
+		want   *time.Time
+	}{
+		{"a.csv", timePtr(base.Add(2 * time.Hour))},
+		{"b.csv", timePtr(base.Add(3 * time.Hour))},
+		{"c.csv", nil},
+	}
+	for _, tt := range tests {
+		got, err := s.Last(tt.source)
+		if err != nil {
+			t.Fatalf("Store.Last(%q) error = %v", tt.source, err)
+		}
+		if (got == nil) != (tt.want == nil) || got != nil && !got.Timestamp.Equal(*tt.want) {
+			t.Errorf("Store.Last(%q) = %v, want %v", tt.source, got, tt.want)
+		}
+	}
+}
+
+func TestStore_LoadSource(t *testing.T) {
+	s := NewStore(t.TempDir())
+	base := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	for i, source := range []string{"a.csv", "b.csv", "a.csv"} {
+		snap := NewSnapshot(base.Add(time.Duration(i)*time.Hour), source, "", nil)
+		if _, err := s.Save(snap); err != nil {
+			t.Fatalf("Store.Save() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		source string
+		want   []time.Time
+	}{
+		{"a.csv", []time.Time{base, base.Add(2 * time.Hour)}},
+		{"b.csv", []time.Time{base.Add(time.Hour)}},
+		{"c.csv", nil},
+	}
+	for _, tt := range tests {
+		snaps, err := s.LoadSource(tt.source)
+		if err != nil {
+			t.Fatalf("Store.LoadSource(%q) error = %v", tt.source, err)
+		}
+		var got []time.Time
+		for _, snap := range snaps {
+			got = append(got, snap.Timestamp.UTC())
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Store.LoadSource(%q) = %v, want %v", tt.source, got, tt.want)
+		}
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestStore_LoadMissingDir(t *testing.T) {
+	got, err := NewStore("does-not-exist").Load()
+	if err != nil || len(got) != 0 {
+		t.Errorf("Store.Load() = %v, %v, want no snapshots", got, err)
+	}
+}
+
+func TestNewTrend(t *testing.T) {
+	snaps := []Snapshot{
+		snapshot("2026-03-01T00:00:00Z",
+			ControlStatus{"AC-Access_Control", "AC-2", "complete", ""},
+			ControlStatus{"AC-Access_Control", "AC-3", "planned", ""},
+			ControlStatus{"AU-Audit_and_Accountability", "AU-2", "partial", ""},
+			ControlStatus{"AU-Audit_and_Accountability", "AU-3", "not applicable", ""},
+		),
+		snapshot("2026-03-15T00:00:00Z",
+			ControlStatus{"AC-Access_Control", "AC-2", "partial", ""},
+			ControlStatus{"AC-Access_Control", "AC-3", "complete", ""},
+			ControlStatus{"AU-Audit_and_Accountability", "AU-2", "complete", ""},
+			ControlStatus{"AU-Audit_and_Accountability", "AU-3", "not applicable", ""},
+		),
+	}
+
+	got := NewTrend(snaps)
+
+	if len(got.Points) != 6 {
+		t.Errorf("NewTrend() points = %v, want 3 per snapshot", got.Points)
+	}
+	wantVelocity := []Velocity{
+		{Family: "AC-Access_Control", First: 50, Last: 50, Completed: 0, Days: 14, PerWeek: 0},
+		{Family: "AU-Audit_and_Accountability", First: 0, Last: 100, Completed: 1, Days: 14, PerWeek: 0.5},
+		{Family: AllFamilies, First: 33.33, Last: 66.67, Completed: 1, Days: 14, PerWeek: 0.5},
+	}
+	if !reflect.DeepEqual(got.Velocity, wantVelocity) {
+		t.Errorf("NewTrend() velocity = %v, want %v", got.Velocity, wantVelocity)
+	}
+	wantRegressions := []Regression{
+		{Timestamp: snaps[1].Timestamp, Family: "AC-Access_Control", ControlKey: "AC-2", From: "complete", To: "partial"},
+	}
+	if !reflect.DeepEqual(got.Regressions, wantRegressions) {
+		t.Errorf("NewTrend() regressions = %v, want %v", got.Regressions, wantRegressions)
+	}
+}
+
+func TestTrend_WriteCSV(t *testing.T) {
+	trend := NewTrend([]Snapshot{
+		snapshot("2026-03-01T00:00:00Z", ControlStatus{"AC-Access_Control", "AC-2", "complete", ""}),
+	})
+
+	var buf bytes.Buffer
+	if err := trend.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `timestamp,revision,family,total,complete,percent
+2026-03-01T00:00:00Z,2026-03-01T00:00:00Z,AC-Access_Control,1,1,100.00
+2026-03-01T00:00:00Z,2026-03-01T00:00:00Z,ALL,1,1,100.00
+`
+	if buf.String() != want {
+		t.Errorf("Trend.WriteCSV() =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestNarrativeChanged(t *testing.T) {
+	snaps := []Snapshot{
+		snapshot("2026-03-01T00:00:00Z",
+			ControlStatus{"AC-Access_Control", "AC-2", "complete", ""},
+			ControlStatus{"AC-Access_Control", "AC-3", "planned", "old"},
+		),
+		snapshot("2026-03-15T00:00:00Z",
+			ControlStatus{"AC-Access_Control", "AC-2", "complete", "a"},
+			ControlStatus{"AC-Access_Control", "AC-3", "planned", "b"},
+		),
+		snapshot("2026-04-01T00:00:00Z",
+			ControlStatus{"AC-Access_Control", "AC-2", "complete", "a"},
+			ControlStatus{"AC-Access_Control", "AC-3", "planned", "c"},
+			ControlStatus{"AC-Access_Control", "AC-4", "planned", ""},
+		),
+	}
+	got := NarrativeChanged(snaps)
+	want := map[string]time.Time{
+		"AC-2": snaps[1].Timestamp,
+		"AC-3": snaps[2].Timestamp,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NarrativeChanged() = %v, want %v", got, want)
+	}
+
+	data := parser.Data{"AC-Access_Control": {
+		"AC-2": v3c.Satisfies{ControlKey: "AC-2", Narrative: []v3c.NarrativeSection{{Key: "b", Text: "b"}, {Key: "a", Text: "a"}}},
+		"AC-3": v3c.Satisfies{ControlKey: "AC-3", Narrative: []v3c.NarrativeSection{{Key: "a", Text: "a"}, {Key: "b", Text: "b"}}},
+	}}
+	snap := NewSnapshot(snaps[0].Timestamp, "sheet", "abc", data)
+	if a, b := snap.Controls[0].Narrative, snap.Controls[1].Narrative; a == "" || a != b {
+		t.Errorf("NewSnapshot() narrative hashes = %q and %q, want the same regardless of order", a, b)
+	}
+}
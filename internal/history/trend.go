@@ -0,0 +1,236 @@
+package history
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// AllFamilies is the family name used for the totals across families.
+const AllFamilies = "ALL"
+
+// Point is the completion of a family at the time of a snapshot. Controls
+// that are not applicable aren't counted.
+type Point struct {
+	Timestamp time.Time `json:"timestamp"`
+	Revision  string    `json:"revision"`
+	Family    string    `json:"family"`
+	Total     int       `json:"total"`
+	Complete  int       `json:"complete"`
+	Percent   float64   `json:"percent"`
+}
+
+// Velocity is the net number of controls completed in a family between the
+// first and the last snapshot.
+type Velocity struct {
+	Family    string  `json:"family"`
+	First     float64 `json:"first_percent"`
+	Last      float64 `json:"last_percent"`
+	Completed int     `json:"completed"`
+	Days      float64 `json:"days"`
+	PerWeek   float64 `json:"per_week"`
+}
+
+// Regression is a control whose status went backwards between two
+// consecutive snapshots.
+type Regression struct {
+	Timestamp  time.Time `json:"timestamp"`
+	Family     string    `json:"family"`
+	ControlKey string    `json:"control_key"`
+	From       string    `json:"from"`
+	To         string    `json:"to"`
+}
+
+// Trend is the evolution of the assessment over the stored snapshots.
+type Trend struct {
+	Points      []Point      `json:"points"`
+	Velocity    []Velocity   `json:"velocity"`
+	Regressions []Regression `json:"regressions"`
+}
+
+// statusRank orders statuses by progress, regressions are moves to a lower
+// rank.
+var statusRank = map[string]int{
+	parser.StatusComplete: 3,
+	parser.StatusPartial:  2,
+	parser.StatusPlanned:  1,
+}
+
+// NewTrend computes the trend of a list of snapshots of a single source
+// sorted by time. Comparing the snapshots of different sources would report
+// false regressions.
+func NewTrend(snaps []Snapshot) Trend {
+	t := Trend{
+		Points:      []Point{},
+		Velocity:    []Velocity{},
+		Regressions: []Regression{},
+	}
+	if len(snaps) == 0 {
+		return t
+	}
+
+	for i, snap := range snaps {
+		t.Points = append(t.Points, points(snap)...)
+		if i > 0 {
+			t.Regressions = append(t.Regressions, Regressions(snaps[i-1], snap)...)
+		}
+	}
+
+	first := pointsByFamily(points(snaps[0]))
+	last := points(snaps[len(snaps)-1])
+	days := snaps[len(snaps)-1].Timestamp.Sub(snaps[0].Timestamp).Hours() / 24
+	for _, l := range last {
+		f := first[l.Family]
+		v := Velocity{
+			Family:    l.Family,
+			First:     f.Percent,
+			Last:      l.Percent,
+			Completed: l.Complete - f.Complete,
+			Days:      round(days),
+		}
+		// Runs less than a day apart don't say much about velocity
+		if days >= 1 {
+			v.PerWeek = round(float64(v.Completed) / days * 7)
+		}
+		t.Velocity = append(t.Velocity, v)
+	}
+	return t
+}
+
+func points(snap Snapshot) []Point {
+	byFamily := make(map[string]*Point)
+	all := &Point{Timestamp: snap.Timestamp, Revision: snap.Revision, Family: AllFamilies}
+	for _, c := range snap.Controls {
+		if c.Status == parser.StatusNotApplicable {
+			continue
+		}
+		p, ok := byFamily[c.Family]
+		if !ok {
+			p = &Point{Timestamp: snap.Timestamp, Revision: snap.Revision, Family: c.Family}
+			byFamily[c.Family] = p
+		}
+		for _, p := range []*Point{p, all} {
+			p.Total++
+			if c.Status == parser.StatusComplete {
+				p.Complete++
+			}
+		}
+	}
+
+	var pts []Point
+	for _, p := range byFamily {
+		pts = append(pts, *p)
+	}
+	sort.Slice(pts, func(i, j int) bool {
+		return pts[i].Family < pts[j].Family
+	})
+	pts = append(pts, *all)
+	for i := range pts {
+		if pts[i].Total > 0 {
+			pts[i].Percent = round(float64(pts[i].Complete) / float64(pts[i].Total) * 100)
+		}
+	}
+	return pts
+}
+
+func pointsByFamily(pts []Point) map[string]Point {
+	m := make(map[string]Point, len(pts))
+	for _, p := range pts {
+		m[p.Family] = p
+	}
+	return m
+}
+
+// Regressions returns the controls whose status went backwards between two
+// snapshots.
+func Regressions(prev, cur Snapshot) []Regression {
+	before := make(map[string]string, len(prev.Controls))
+	for _, c := range prev.Controls {
+		before[c.ControlKey] = c.Status
+	}
+
+	var regs []Regression
+	for _, c := range cur.Controls {
+		old, ok := before[c.ControlKey]
+		if !ok || old == parser.StatusNotApplicable || c.Status == parser.StatusNotApplicable {
+			continue
+		}
+		if statusRank[c.Status] < statusRank[old] {
+			regs = append(regs, Regression{
+				Timestamp:  cur.Timestamp,
+				Family:     c.Family,
+				ControlKey: c.ControlKey,
+				From:       old,
+				To:         c.Status,
+			})
+		}
+	}
+	return regs
+}
+
+func round(f float64) float64 {
+	return float64(int64(f*100+0.5)) / 100
+}
+
+// WriteText writes a summary of the trend per family followed by the
+// regressions.
+func (t Trend) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "FAMILY\tFIRST\tLAST\tCOMPLETED\tPER WEEK\n")
+	for _, v := range t.Velocity {
+		fmt.Fprintf(tw, "%s\t%.1f%%\t%.1f%%\t%+d\t%.2f\n", v.Family, v.First, v.Last, v.Completed, v.PerWeek)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(t.Regressions) == 0 {
+		_, err := fmt.Fprintf(w, "\nNo regressions\n")
+		return err
+	}
+	fmt.Fprintf(w, "\nRegressions\n")
+	for _, r := range t.Regressions {
+		fmt.Fprintf(w, "%s %s: %s -> %s\n", r.Timestamp.Format(time.RFC3339), r.ControlKey, r.From, orNone(r.To))
+	}
+	return nil
+}
+
+// WriteCSV writes the completion of every family per snapshot, one line per
+// point, which is what charting tools expect.
+func (t Trend) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"timestamp", "revision", "family", "total", "complete", "percent"})
+	for _, p := range t.Points {
+		cw.Write([]string{
+			p.Timestamp.Format(time.RFC3339),
+			p.Revision,
+			p.Family,
+			strconv.Itoa(p.Total),
+			strconv.Itoa(p.Complete),
+			strconv.FormatFloat(p.Percent, 'f', 2, 64),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the whole trend as JSON.
+func (t Trend) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(t)
+}
+
+func orNone(status string) string {
+	if status == "" {
+		return "(unset)"
+	}
+	return status
+}
@@ -0,0 +1,196 @@
+// Package jira tracks the open controls of the assessment with issues, using
+// the Jira REST API v2.
+package jira
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Client calls the REST API of a Jira server.
+type Client struct {
+	// BaseURL is the URL of the server, e.g. https://example.atlassian.net
+	BaseURL string
+	// User is the user of the API token, empty for a personal access token,
+	// which is sent as a bearer token
+	User  string
+	Token string
+	// HTTPClient defaults to a client with a 30 seconds timeout
+	HTTPClient *http.Client
+}
+
+// Issue is a Jira issue with the fields the tool uses.
+type Issue struct {
+	Key    string `json:"key,omitempty"`
+	Fields Fields `json:"fields"`
+}
+
+// Fields are the fields of an issue.
+type Fields struct {
+	Project     *Ref     `json:"project,omitempty"`
+	IssueType   *Ref     `json:"issuetype,omitempty"`
+	Summary     string   `json:"summary,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+	// DueDate is formatted as YYYY-MM-DD
+	DueDate string  `json:"duedate,omitempty"`
+	Status  *Status `json:"status,omitempty"`
+}
+
+// Ref references a project by key or an issue type by name.
+type Ref struct {
+	Key  string `json:"key,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// Status is the workflow status of an issue.
+type Status struct {
+	Name           string `json:"name"`
+	StatusCategory struct {
+		// Key is new, indeterminate or done
+		Key string `json:"key"`
+	} `json:"statusCategory"`
+}
+
+// Transition moves an issue to another status.
+type Transition struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	To   Status `json:"to"`
+}
+
+// apiError is the body of the API errors.
+type apiError struct {
+	ErrorMessages []string          `json:"errorMessages"`
+	Errors        map[string]string `json:"errors"`
+}
+
+func (c *Client) do(method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, strings.TrimSuffix(c.BaseURL, "/")+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.User != "" {
+		req.SetBasicAuth(c.User, c.Token)
+	} else if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	client := c.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("jira: %s %s: %s%s", method, path, resp.Status, errorDetails(b))
+	}
+	if out == nil || len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
+
+// errorDetails returns the messages of an API error body, if any.
+func errorDetails(body []byte) string {
+	var e apiError
+	if json.Unmarshal(body, &e) != nil {
+		return ""
+	}
+	msgs := e.ErrorMessages
+	var fields []string
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		msgs = append(msgs, field+": "+e.Errors[field])
+	}
+	if len(msgs) == 0 {
+		return ""
+	}
+	return ": " + strings.Join(msgs, "; ")
+}
+
+// Search returns the issues matching a JQL query, with the fields the tool
+// uses.
+func (c *Client) Search(jql string) ([]Issue, error) {
+	var issues []Issue
+	for {
+		q := url.Values{
+			"jql":        {jql},
+			"fields":     {"summary,description,labels,duedate,status"},
+			"startAt":    {fmt.Sprint(len(issues))},
+			"maxResults": {"100"},
+		}
+		var page struct {
+			Total  int     `json:"total"`
+			Issues []Issue `json:"issues"`
+		}
+		if err := c.do("GET", "/rest/api/2/search?"+q.Encode(), nil, &page); err != nil {
+			return nil, err
+		}
+		issues = append(issues, page.Issues...)
+		if len(page.Issues) == 0 || len(issues) >= page.Total {
+			return issues, nil
+		}
+	}
+}
+
+// CreateIssue creates an issue and returns its key.
+func (c *Client) CreateIssue(f Fields) (string, error) {
+	var created struct {
+		Key string `json:"key"`
+	}
+	if err := c.do("POST", "/rest/api/2/issue", Issue{Fields: f}, &created); err != nil {
+		return "", err
+	}
+	return created.Key, nil
+}
+
+// UpdateIssue sets fields of an issue, a nil value clearing the field.
+func (c *Client) UpdateIssue(key string, fields map[string]interface{}) error {
+	return c.do("PUT", "/rest/api/2/issue/"+url.PathEscape(key), map[string]interface{}{"fields": fields}, nil)
+}
+
+// Transitions returns the transitions available on an issue.
+func (c *Client) Transitions(key string) ([]Transition, error) {
+	var resp struct {
+		Transitions []Transition `json:"transitions"`
+	}
+	err := c.do("GET", "/rest/api/2/issue/"+url.PathEscape(key)+"/transitions", nil, &resp)
+	return resp.Transitions, err
+}
+
+// DoTransition moves an issue through a transition.
+func (c *Client) DoTransition(key, id string) error {
+	in := map[string]interface{}{"transition": map[string]string{"id": id}}
+	return c.do("POST", "/rest/api/2/issue/"+url.PathEscape(key)+"/transitions", in, nil)
+}
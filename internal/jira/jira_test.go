@@ -0,0 +1,264 @@
+package jira
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/catalog"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+var opts = Options{Project: "SEC", IssueType: "Task", Label: "autocmp"}
+
+// fakeJira serves the part of the REST API the client uses from memory.
+type fakeJira struct {
+	mu     sync.Mutex
+	issues map[string]*Issue
+	order  []string
+	jql    []string
+}
+
+func newFakeJira() *fakeJira {
+	return &fakeJira{issues: make(map[string]*Issue)}
+}
+
+func (f *fakeJira) add(fields Fields) string {
+	key := fmt.Sprintf("SEC-%d", len(f.order)+1)
+	if fields.Status == nil {
+		fields.Status = &Status{Name: "To Do"}
+		fields.Status.StatusCategory.Key = "new"
+	}
+	fields.Project, fields.IssueType = nil, nil
+	f.issues[key] = &Issue{Key: key, Fields: fields}
+	f.order = append(f.order, key)
+	return key
+}
+
+func (f *fakeJira) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if user, token, ok := r.BasicAuth(); !ok || user != "bot" || token != "secret" {
+		http.Error(w, `{"errorMessages": ["unauthorized"]}`, http.StatusUnauthorized)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/rest/api/2/")
+	switch {
+	case r.Method == "GET" && path == "search":
+		f.jql = append(f.jql, r.URL.Query().Get("jql"))
+		issues := []Issue{}
+		for _, key := range f.order {
+			issue := f.issues[key]
+			if issue.Fields.Status.StatusCategory.Key != "done" {
+				issues = append(issues, *issue)
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"total": len(issues), "issues": issues})
+	case r.Method == "POST" && path == "issue":
+		var in Issue
+		json.NewDecoder(r.Body).Decode(&in)
+		if in.Fields.Project == nil || in.Fields.Project.Key != "SEC" {
+			http.Error(w, `{"errors": {"project": "project is required"}}`, http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"key": f.add(in.Fields)})
+	case strings.HasSuffix(path, "/transitions"):
+		issue, ok := f.issues[strings.TrimSuffix(strings.TrimPrefix(path, "issue/"), "/transitions")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"transitions": [
+				{"id": "11", "name": "Start", "to": {"name": "In Progress", "statusCategory": {"key": "indeterminate"}}},
+				{"id": "31", "name": "Resolve", "to": {"name": "Done", "statusCategory": {"key": "done"}}}
+			]}`)
+			return
+		}
+		var in struct {
+			Transition struct{ ID string } `json:"transition"`
+		}
+		json.NewDecoder(r.Body).Decode(&in)
+		if in.Transition.ID == "31" {
+			issue.Fields.Status = &Status{Name: "Done"}
+			issue.Fields.Status.StatusCategory.Key = "done"
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == "PUT" && strings.HasPrefix(path, "issue/"):
+		issue, ok := f.issues[strings.TrimPrefix(path, "issue/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		var in struct {
+			Fields map[string]*string `json:"fields"`
+		}
+		json.NewDecoder(r.Body).Decode(&in)
+		for name, value := range in.Fields {
+			v := ""
+			if value != nil {
+				v = *value
+			}
+			switch name {
+			case "summary":
+				issue.Fields.Summary = v
+			case "description":
+				issue.Fields.Description = v
+			case "duedate":
+				issue.Fields.DueDate = v
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func testControls() []Control {
+	data := parser.Data{
+		"AC-Access_Control": {
+			"AC-2": v3c.Satisfies{ControlKey: "AC-2", ImplementationStatus: parser.StatusPlanned,
+				Narrative: []v3c.NarrativeSection{{Key: "a", Text: "Accounts are defined."}}},
+			"AC-3":     v3c.Satisfies{ControlKey: "AC-3", ImplementationStatus: parser.StatusComplete},
+			"AC-2 (1)": v3c.Satisfies{ControlKey: "AC-2 (1)"},
+		},
+	}
+	details := map[string]parser.Details{
+		"AC-2": {Owner: "alice", Due: "2026-11-01"},
+	}
+	cat := &catalog.Catalog{Text: map[string]string{"AC-2": "a. Define the types of accounts allowed;"}}
+	return NewControls(data, details, []string{"AC-4"}, cat)
+}
+
+func TestNewControls(t *testing.T) {
+	want := []Control{
+		{Key: "AC-2", Status: parser.StatusPlanned, Text: "a. Define the types of accounts allowed;", Narrative: "a. Accounts are defined.", Owner: "alice", Due: "2026-11-01"},
+		{Key: "AC-2 (1)"},
+		{Key: "AC-3", Status: parser.StatusComplete},
+		{Key: "AC-4", Missing: true},
+	}
+	if got := testControls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewControls() = %+v, want %+v", got, want)
+	}
+}
+
+func TestPlan(t *testing.T) {
+	controls := testControls()
+	issue := func(key string, c Control) Issue {
+		return Issue{Key: key, Fields: fields(c, opts)}
+	}
+	stale := issue("SEC-2", controls[0])
+	stale.Fields.DueDate = "2026-10-01"
+
+	tests := []struct {
+		name   string
+		issues []Issue
+		want   []string
+	}{
+		{"no issues", nil, []string{
+			"create new issue for AC-2: planned",
+			"create new issue for AC-2 (1): no status",
+			"create new issue for AC-4: missing from the assessment",
+		}},
+		{"up to date", []Issue{issue("SEC-1", controls[0]), issue("SEC-2", controls[1]), issue("SEC-3", controls[3])}, nil},
+		{"stale and complete", []Issue{stale, issue("SEC-3", controls[2]), issue("SEC-4", controls[3]), issue("SEC-5", controls[1])}, []string{
+			"update SEC-2 for AC-2: duedate",
+			"close SEC-3 for AC-3: complete",
+		}},
+		{"duplicates", []Issue{issue("SEC-1", controls[0]), stale, issue("SEC-3", controls[1]), issue("SEC-4", controls[3])}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, a := range Plan(controls, tt.issues, opts) {
+				got = append(got, a.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Plan() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Sync(t *testing.T) {
+	fake := newFakeJira()
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+	c := &Client{BaseURL: srv.URL, User: "bot", Token: "secret"}
+
+	sync := func(controls []Control) []string {
+		issues, err := c.OpenIssues(opts)
+		if err != nil {
+			t.Fatalf("OpenIssues() error = %v", err)
+		}
+		var out bytes.Buffer
+		if err := c.Apply(Plan(controls, issues, opts), opts, &out); err != nil {
+			t.Fatalf("Apply() error = %v", err)
+		}
+		return strings.Split(strings.TrimSpace(out.String()), "\n")
+	}
+
+	controls := testControls()
+	got := sync(controls)
+	want := []string{
+		"create SEC-1 for AC-2: planned",
+		"create SEC-2 for AC-2 (1): no status",
+		"create SEC-3 for AC-4: missing from the assessment",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("first sync = %q, want %q", got, want)
+	}
+	if got := fake.issues["SEC-1"].Fields; got.DueDate != "2026-11-01" || !reflect.DeepEqual(got.Labels, []string{"autocmp", "control-AC-2"}) ||
+		!strings.Contains(got.Description, "{quote}a. Accounts are defined.{quote}") || !strings.Contains(got.Description, "*Owner:* alice") {
+		t.Errorf("SEC-1 fields = %+v", got)
+	}
+	if want := `project = "SEC" AND labels = "autocmp" AND statusCategory != Done ORDER BY key`; fake.jql[0] != want {
+		t.Errorf("jql = %q, want %q", fake.jql[0], want)
+	}
+
+	controls[0].Due = ""
+	controls[1].Status = parser.StatusComplete
+	got = sync(controls)
+	want = []string{
+		"update SEC-1 for AC-2: description, duedate",
+		"close SEC-2 for AC-2 (1): complete",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("second sync = %q, want %q", got, want)
+	}
+	if fake.issues["SEC-1"].Fields.DueDate != "" || fake.issues["SEC-2"].Fields.Status.StatusCategory.Key != "done" {
+		t.Errorf("issues after the second sync = %+v, %+v", fake.issues["SEC-1"].Fields, fake.issues["SEC-2"].Fields)
+	}
+
+	if got := sync(controls); !reflect.DeepEqual(got, []string{""}) {
+		t.Errorf("third sync = %q, want no change", got)
+	}
+}
+
+func TestClient_Errors(t *testing.T) {
+	srv := httptest.NewServer(newFakeJira())
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, User: "bot", Token: "wrong"}
+	if _, err := c.OpenIssues(opts); err == nil || !strings.Contains(err.Error(), "401 Unauthorized: unauthorized") {
+		t.Errorf("OpenIssues() error = %v, want unauthorized", err)
+	}
+
+	c.Token = "secret"
+	_, err := c.CreateIssue(Fields{Summary: "no project"})
+	if err == nil || !strings.Contains(err.Error(), "project: project is required") {
+		t.Errorf("CreateIssue() error = %v, want the field error", err)
+	}
+
+	if err := c.close("SEC-9", ""); err == nil {
+		t.Errorf("close() of an unknown issue succeeded")
+	}
+}
@@ -0,0 +1,270 @@
+package jira
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/catalog"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// Control is a control of the assessment, or of the baseline when it is
+// missing from the assessment.
+type Control struct {
+	Key string
+	// Status is the implementation status, "" when the control has none
+	Status  string
+	Missing bool
+	// Text is the statement of the control, "" when the catalog doesn't have
+	// it
+	Text      string
+	Narrative string
+	Owner     string
+	Due       string
+}
+
+// Open tells whether the control needs an open issue.
+func (c Control) Open() bool {
+	return c.Missing || c.Status != parser.StatusComplete && c.Status != parser.StatusNotApplicable
+}
+
+// NewControls returns the controls of the parsed data followed by the
+// controls missing from it, e.g. the Missing of report.Gaps, sorted by key.
+func NewControls(data parser.Data, details map[string]parser.Details, missing []string, cat *catalog.Catalog) []Control {
+	var controls []Control
+	for _, ctrls := range data {
+		for key, ctrl := range ctrls {
+			var narratives []string
+			for _, n := range ctrl.Narrative {
+				text := strings.TrimSpace(n.Text)
+				if n.Key != "" {
+					text = n.Key + ". " + text
+				}
+				narratives = append(narratives, text)
+			}
+			controls = append(controls, Control{
+				Key:       key,
+				Status:    ctrl.ImplementationStatus,
+				Text:      cat.ControlText(key),
+				Narrative: strings.Join(narratives, "\n"),
+				Owner:     details[key].Owner,
+				Due:       details[key].Due,
+			})
+		}
+	}
+	for _, key := range missing {
+		controls = append(controls, Control{Key: key, Missing: true, Text: cat.ControlText(key)})
+	}
+	sort.Slice(controls, func(i, j int) bool {
+		return sortorder.NaturalLess(controls[i].Key, controls[j].Key)
+	})
+	return controls
+}
+
+// Options configure the issues.
+type Options struct {
+	Project   string
+	IssueType string
+	// Label marks the issues managed by the tool, which also have the label
+	// of their control, see ControlLabel
+	Label string
+	// CloseTransition is the name of the transition closing the issues,
+	// empty for the first transition to a done status
+	CloseTransition string
+}
+
+// ControlLabel returns the label deduplicating the issues of a control.
+// Labels can't contain spaces, so "AC-2 (1)" is labeled control-AC-2(1).
+func ControlLabel(controlKey string) string {
+	return "control-" + strings.Replace(controlKey, " ", "", -1)
+}
+
+// OpenIssues returns the issues managed by the tool that aren't done.
+func (c *Client) OpenIssues(opts Options) ([]Issue, error) {
+	return c.Search(fmt.Sprintf("project = %q AND labels = %q AND statusCategory != Done ORDER BY key", opts.Project, opts.Label))
+}
+
+// Action kinds.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionClose  = "close"
+)
+
+// Action is a change to the issues.
+type Action struct {
+	Kind string
+	// Issue is the key of the issue, empty until an issue is created
+	Issue   string
+	Control Control
+	// Changed are the fields an update sets
+	Changed []string
+}
+
+func (a Action) String() string {
+	issue := a.Issue
+	if issue == "" {
+		issue = "new issue"
+	}
+	switch a.Kind {
+	case ActionUpdate:
+		return fmt.Sprintf("update %s for %s: %s", issue, a.Control.Key, strings.Join(a.Changed, ", "))
+	case ActionClose:
+		return fmt.Sprintf("close %s for %s: %s", issue, a.Control.Key, a.Control.Status)
+	default:
+		return fmt.Sprintf("create %s for %s: %s", issue, a.Control.Key, state(a.Control))
+	}
+}
+
+// state describes why a control is open.
+func state(c Control) string {
+	switch {
+	case c.Missing:
+		return "missing from the assessment"
+	case c.Status == "":
+		return "no status"
+	default:
+		return c.Status
+	}
+}
+
+// fields returns the fields of the issue of a control.
+func fields(c Control, opts Options) Fields {
+	var b strings.Builder
+	fmt.Fprintf(&b, "h3. Control\n")
+	if c.Text != "" {
+		fmt.Fprintf(&b, "%s\n", c.Text)
+	} else {
+		fmt.Fprintf(&b, "See NIST SP 800-53 %s.\n", c.Key)
+	}
+	fmt.Fprintf(&b, "\nh3. Narrative\n")
+	if c.Narrative != "" {
+		fmt.Fprintf(&b, "{quote}%s{quote}\n", c.Narrative)
+	} else {
+		fmt.Fprintf(&b, "_No narrative._\n")
+	}
+	fmt.Fprintf(&b, "\n*Status:* %s\n", state(c))
+	owner := c.Owner
+	if owner == "" {
+		owner = "unassigned"
+	}
+	fmt.Fprintf(&b, "*Owner:* %s\n", owner)
+	if c.Due != "" {
+		fmt.Fprintf(&b, "*Due:* %s\n", c.Due)
+	}
+	fmt.Fprintf(&b, "\n_Managed by autocmp: the summary, description and due date are overwritten from the assessment._")
+	return Fields{
+		Project:     &Ref{Key: opts.Project},
+		IssueType:   &Ref{Name: opts.IssueType},
+		Summary:     fmt.Sprintf("%s: %s", c.Key, state(c)),
+		Description: b.String(),
+		Labels:      []string{opts.Label, ControlLabel(c.Key)},
+		DueDate:     c.Due,
+	}
+}
+
+// Plan returns the changes bringing the open issues in line with the
+// controls: an issue is created for every open control without one, updated
+// when its fields differ and closed once the control is complete or not
+// applicable. When a control has several issues, only the first one is
+// managed; the issues of controls no longer assessed are left alone.
+func Plan(controls []Control, issues []Issue, opts Options) []Action {
+	byLabel := make(map[string]Issue)
+	for _, issue := range issues {
+		for _, label := range issue.Fields.Labels {
+			if _, ok := byLabel[label]; !ok {
+				byLabel[label] = issue
+			}
+		}
+	}
+
+	var actions []Action
+	for _, c := range controls {
+		issue, ok := byLabel[ControlLabel(c.Key)]
+		switch {
+		case !ok && c.Open():
+			actions = append(actions, Action{Kind: ActionCreate, Control: c})
+		case ok && !c.Open():
+			actions = append(actions, Action{Kind: ActionClose, Issue: issue.Key, Control: c})
+		case ok:
+			want := fields(c, opts)
+			var changed []string
+			if issue.Fields.Summary != want.Summary {
+				changed = append(changed, "summary")
+			}
+			if issue.Fields.Description != want.Description {
+				changed = append(changed, "description")
+			}
+			if issue.Fields.DueDate != want.DueDate {
+				changed = append(changed, "duedate")
+			}
+			if len(changed) > 0 {
+				actions = append(actions, Action{Kind: ActionUpdate, Issue: issue.Key, Control: c, Changed: changed})
+			}
+		}
+	}
+	return actions
+}
+
+// Apply makes the changes, stopping at the first error, and writes a line
+// per change made.
+func (c *Client) Apply(actions []Action, opts Options, w io.Writer) error {
+	for _, a := range actions {
+		f := fields(a.Control, opts)
+		switch a.Kind {
+		case ActionCreate:
+			key, err := c.CreateIssue(f)
+			if err != nil {
+				return fmt.Errorf("creating the issue of %s: %v", a.Control.Key, err)
+			}
+			a.Issue = key
+		case ActionUpdate:
+			update := make(map[string]interface{})
+			for _, name := range a.Changed {
+				switch name {
+				case "summary":
+					update[name] = f.Summary
+				case "description":
+					update[name] = f.Description
+				case "duedate":
+					if f.DueDate == "" {
+						update[name] = nil
+					} else {
+						update[name] = f.DueDate
+					}
+				}
+			}
+			if err := c.UpdateIssue(a.Issue, update); err != nil {
+				return fmt.Errorf("updating %s: %v", a.Issue, err)
+			}
+		case ActionClose:
+			if err := c.close(a.Issue, opts.CloseTransition); err != nil {
+				return fmt.Errorf("closing %s: %v", a.Issue, err)
+			}
+		}
+		fmt.Fprintln(w, a)
+	}
+	return nil
+}
+
+// close moves an issue through the transition of the given name, or the
+// first transition to a done status.
+func (c *Client) close(key, name string) error {
+	transitions, err := c.Transitions(key)
+	if err != nil {
+		return err
+	}
+	for _, t := range transitions {
+		if name != "" && strings.EqualFold(t.Name, name) || name == "" && t.To.StatusCategory.Key == "done" {
+			return c.DoTransition(key, t.ID)
+		}
+	}
+	if name != "" {
+		return fmt.Errorf("no transition named %q", name)
+	}
+	return fmt.Errorf("no transition to a done status")
+}
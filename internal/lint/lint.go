@@ -0,0 +1,351 @@
+// Package lint checks the narratives of the assessment for text that isn't
+// ready to be handed to an auditor, such as placeholders or leftover TODOs.
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// Rules checked by Lint.
+const (
+	// RulePlaceholder flags rows without narrative, or with the placeholder
+	// text set by the parser
+	RulePlaceholder = "placeholder"
+	// RuleTooShort flags narratives shorter than Config.MinLength
+	RuleTooShort = "too-short"
+	// RuleDuplicate flags narratives copied to more than
+	// Config.MaxDuplicates controls
+	RuleDuplicate = "duplicate"
+	// RuleFutureTense flags narratives of complete controls saying what
+	// "will" be done
+	RuleFutureTense = "future-tense"
+	// RuleTodo flags narratives containing TODO, TBD or FIXME
+	RuleTodo = "todo"
+	// RuleProductName flags narratives naming one of
+	// Config.OtherProducts, usually left over from another assessment
+	RuleProductName = "product-name"
+)
+
+// defaultSeverities are the severities of the rules unless configured.
+var defaultSeverities = map[string]string{
+	RulePlaceholder: parser.SeverityError,
+	RuleTooShort:    parser.SeverityWarning,
+	RuleDuplicate:   parser.SeverityWarning,
+	RuleFutureTense: parser.SeverityWarning,
+	RuleTodo:        parser.SeverityError,
+	RuleProductName: parser.SeverityError,
+}
+
+// Rules returns the names of the rules, sorted.
+func Rules() []string {
+	var rules []string
+	for rule := range defaultSeverities {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+	return rules
+}
+
+// RuleConfig configures a rule.
+type RuleConfig struct {
+	Disabled bool `yaml:"disabled"`
+	// Severity overrides the severity of the findings, error or warning
+	Severity string `yaml:"severity"`
+}
+
+// Config configures the rules, see DefaultConfig.
+type Config struct {
+	Rules map[string]RuleConfig `yaml:"rules"`
+	// MinLength is the number of characters under which a narrative is too
+	// short
+	MinLength int `yaml:"min-length"`
+	// MaxDuplicates is the number of controls a narrative can be shared by
+	MaxDuplicates int `yaml:"max-duplicates"`
+	// Product is the name of the product the assessment is about
+	Product string `yaml:"product"`
+	// OtherProducts are names that shouldn't appear in the narratives
+	OtherProducts []string `yaml:"other-products"`
+}
+
+// DefaultConfig returns the config used when there is no config file.
+func DefaultConfig() Config {
+	return Config{Rules: make(map[string]RuleConfig), MinLength: 40, MaxDuplicates: 3}
+}
+
+// LoadConfig reads a config file on top of the default config, e.g.
+//
+//	min-length: 80
+//	product: OpenShift Container Platform
+//	other-products: [Red Hat Enterprise Linux, OpenStack]
+//	rules:
+//	  duplicate:
+//	    disabled: true
+//	  future-tense:
+//	    severity: error
+func LoadConfig(path string) (Config, error) {
+	cfg := DefaultConfig()
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err := yaml.UnmarshalStrict(b, &cfg); err != nil {
+		return cfg, fmt.Errorf("%s: %v", path, err)
+	}
+	if err := cfg.Check(); err != nil {
+		return cfg, fmt.Errorf("%s: %v", path, err)
+	}
+	return cfg, nil
+}
+
+// Check returns an error for unknown rules and severities.
+func (c Config) Check() error {
+	for rule, rc := range c.Rules {
+		if _, ok := defaultSeverities[rule]; !ok {
+			return fmt.Errorf("unknown rule %q, expected one of %s", rule, strings.Join(Rules(), ", "))
+		}
+		if s := rc.Severity; s != "" && s != parser.SeverityError && s != parser.SeverityWarning {
+			return fmt.Errorf("rule %s: severity must be error or warning, got %q", rule, s)
+		}
+	}
+	return nil
+}
+
+// Disable disables a rule.
+func (c *Config) Disable(rule string) error {
+	if _, ok := defaultSeverities[rule]; !ok {
+		return fmt.Errorf("unknown rule %q, expected one of %s", rule, strings.Join(Rules(), ", "))
+	}
+	if c.Rules == nil {
+		c.Rules = make(map[string]RuleConfig)
+	}
+	rc := c.Rules[rule]
+	rc.Disabled = true
+	c.Rules[rule] = rc
+	return nil
+}
+
+func (c Config) enabled(rule string) bool {
+	return !c.Rules[rule].Disabled
+}
+
+func (c Config) severity(rule string) string {
+	if s := c.Rules[rule].Severity; s != "" {
+		return s
+	}
+	return defaultSeverities[rule]
+}
+
+// Finding is a problem found in the narrative of a row.
+type Finding struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	// Line is the spreadsheet row of the narrative (0 if unknown)
+	Line         int    `json:"line"`
+	Control      string `json:"control"`
+	ControlKey   string `json:"control_key"`
+	NarrativeKey string `json:"narrative_key,omitempty"`
+	Message      string `json:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("row %d: %s: %s: %s [%s]", f.Line, f.Severity, f.Control, f.Message, f.Rule)
+}
+
+var (
+	futureRe = regexp.MustCompile(`(?i)\bwill\b`)
+	todoRe   = regexp.MustCompile(`(?i)\b(TODO|TBD|FIXME)\b`)
+	spaceRe  = regexp.MustCompile(`\s+`)
+)
+
+type narrative struct {
+	row        parser.Row
+	controlKey string
+	key        string
+	text       string
+}
+
+// Lint checks the narrative of every row, and returns the findings sorted by
+// line. Rows that can't be parsed are skipped, the parser reports them.
+func Lint(rows []parser.Row, cfg Config) []Finding {
+	p := parser.NewParser()
+	var narratives []narrative
+	for _, row := range rows {
+		if row.Family == "" && row.Control == "" {
+			continue
+		}
+		if err := p.ParseRow(row); err != nil {
+			continue
+		}
+		key, n, _ := p.Narrative(row)
+		narratives = append(narratives, narrative{row, key, n.Key, n.Text})
+	}
+	data := p.GetData()
+	status := make(map[string]string)
+	keyed := make(map[string]bool)
+	for _, ctrls := range data {
+		for key, ctrl := range ctrls {
+			status[key] = ctrl.ImplementationStatus
+			for _, n := range ctrl.Narrative {
+				keyed[key] = keyed[key] || n.Key != ""
+			}
+		}
+	}
+
+	l := linter{cfg: cfg}
+	shared := sharedBy(narratives)
+	for _, n := range narratives {
+		if parser.IsPlaceholder(n.text) {
+			// the placeholder of a control with parts is dropped by the
+			// parser, see removeNarrative
+			if n.key == "" && keyed[n.controlKey] {
+				continue
+			}
+			l.add(RulePlaceholder, n, "no narrative")
+			continue
+		}
+		if len(n.text) < cfg.MinLength {
+			l.add(RuleTooShort, n, "narrative is %d characters long, expected at least %d", len(n.text), cfg.MinLength)
+		}
+		if controls := shared[normalize(n.text)]; len(controls) > cfg.MaxDuplicates {
+			l.add(RuleDuplicate, n, "same narrative as %d controls: %s", len(controls), summarize(controls, 5))
+		}
+		if status[n.controlKey] == parser.StatusComplete {
+			if m := futureRe.FindString(n.text); m != "" {
+				l.add(RuleFutureTense, n, "control is complete but the narrative says what %q be done", m)
+			}
+		}
+		if m := todoRe.FindString(n.text); m != "" {
+			l.add(RuleTodo, n, "narrative contains %q", m)
+		}
+		if name := otherProduct(n.text, cfg.Product, cfg.OtherProducts); name != "" {
+			l.add(RuleProductName, n, "narrative mentions %q", name)
+		}
+	}
+
+	sort.SliceStable(l.findings, func(i, j int) bool {
+		return l.findings[i].Line < l.findings[j].Line
+	})
+	return l.findings
+}
+
+type linter struct {
+	cfg      Config
+	findings []Finding
+}
+
+func (l *linter) add(rule string, n narrative, format string, args ...interface{}) {
+	if !l.cfg.enabled(rule) {
+		return
+	}
+	l.findings = append(l.findings, Finding{
+		Rule:         rule,
+		Severity:     l.cfg.severity(rule),
+		Line:         n.row.Line,
+		Control:      n.row.Control,
+		ControlKey:   n.controlKey,
+		NarrativeKey: n.key,
+		Message:      fmt.Sprintf(format, args...),
+	})
+}
+
+// normalize returns the text compared to find copy-pasted narratives.
+func normalize(text string) string {
+	return strings.ToLower(spaceRe.ReplaceAllString(strings.TrimSpace(text), " "))
+}
+
+// sharedBy returns the controls of every normalized narrative, in natural
+// order.
+func sharedBy(narratives []narrative) map[string][]string {
+	shared := make(map[string][]string)
+	for _, n := range narratives {
+		if parser.IsPlaceholder(n.text) {
+			continue
+		}
+		text := normalize(n.text)
+		if !contains(shared[text], n.controlKey) {
+			shared[text] = append(shared[text], n.controlKey)
+		}
+	}
+	for _, controls := range shared {
+		sort.Slice(controls, func(i, j int) bool {
+			return sortorder.NaturalLess(controls[i], controls[j])
+		})
+	}
+	return shared
+}
+
+func summarize(list []string, max int) string {
+	if len(list) <= max {
+		return strings.Join(list, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(list[:max], ", "), len(list)-max)
+}
+
+// otherProduct returns the first of the other products the text mentions.
+// Mentions of the product itself are ignored, so that other products it
+// contains the name of aren't reported.
+func otherProduct(text, product string, others []string) string {
+	if product != "" {
+		text = wordRe(product).ReplaceAllString(text, " ")
+	}
+	for _, other := range others {
+		if other != "" && wordRe(other).MatchString(text) {
+			return other
+		}
+	}
+	return ""
+}
+
+func wordRe(name string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(name) + `\b`)
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of findings of a severity.
+func Count(findings []Finding, severity string) int {
+	n := 0
+	for _, f := range findings {
+		if f.Severity == severity {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteText writes a line per finding, followed by a summary.
+func WriteText(w io.Writer, findings []Finding) error {
+	for _, f := range findings {
+		fmt.Fprintln(w, f)
+	}
+	_, err := fmt.Fprintf(w, "%d errors, %d warnings\n", Count(findings, parser.SeverityError), Count(findings, parser.SeverityWarning))
+	return err
+}
+
+// WriteJSON writes the findings as a JSON array.
+func WriteJSON(w io.Writer, findings []Finding) error {
+	if findings == nil {
+		findings = []Finding{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(findings)
+}
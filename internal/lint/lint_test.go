@@ -0,0 +1,86 @@
+package lint
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+const copied = "Access is managed by the identity provider of the platform."
+
+func TestLint(t *testing.T) {
+	rows := []parser.Row{
+		{Line: 2, Family: "ACCESS CONTROL", Control: "AC-1"},
+		// the placeholder of a control with parts isn't published
+		{Line: 3, Family: "ACCESS CONTROL", Control: "AC-2"},
+		{Line: 4, Family: "ACCESS CONTROL", Control: "AC-2a.", Narrative: "Text for enhancement"},
+		{Line: 5, Family: "ACCESS CONTROL", Control: "AC-2b.", Narrative: "TBD", Status: "Complete"},
+		{Line: 6, Family: "ACCESS CONTROL", Control: "AC-3", Narrative: copied},
+		{Line: 7, Family: "ACCESS CONTROL", Control: "AC-4", Narrative: copied},
+		{Line: 8, Family: "ACCESS CONTROL", Control: "AC-5", Narrative: "Access  is managed by the identity provider of the platform. "},
+		{Line: 9, Family: "AUDIT AND ACCOUNTABILITY", Control: "AU-2", Narrative: "The audit policy will be configured by the cluster administrator.", Status: "Complete"},
+		{Line: 10, Family: "AUDIT AND ACCOUNTABILITY", Control: "AU-3", Narrative: "Audit records of OpenShift and of Red Hat Enterprise Linux are kept.", Status: "Planned"},
+		{Line: 11, Family: "AUDIT AND ACCOUNTABILITY", Control: "AU-4", Narrative: "Audit storage of OpenShift Dedicated is managed by Red Hat."},
+		{Line: 12, Family: "AUDIT AND ACCOUNTABILITY", Control: "bogus"},
+	}
+	cfg := DefaultConfig()
+	cfg.MaxDuplicates = 2
+	cfg.Product = "OpenShift Dedicated"
+	cfg.OtherProducts = []string{"Red Hat Enterprise Linux", "OpenShift"}
+	cfg.Rules[RuleTooShort] = RuleConfig{Severity: parser.SeverityError}
+
+	got := Lint(rows, cfg)
+	want := []Finding{
+		{RulePlaceholder, parser.SeverityError, 2, "AC-1", "AC-1", "", "no narrative"},
+		{RulePlaceholder, parser.SeverityError, 4, "AC-2a.", "AC-2", "a", "no narrative"},
+		{RuleTooShort, parser.SeverityError, 5, "AC-2b.", "AC-2", "b", "narrative is 3 characters long, expected at least 40"},
+		{RuleTodo, parser.SeverityError, 5, "AC-2b.", "AC-2", "b", `narrative contains "TBD"`},
+		{RuleDuplicate, parser.SeverityWarning, 6, "AC-3", "AC-3", "", "same narrative as 3 controls: AC-3, AC-4, AC-5"},
+		{RuleDuplicate, parser.SeverityWarning, 7, "AC-4", "AC-4", "", "same narrative as 3 controls: AC-3, AC-4, AC-5"},
+		{RuleDuplicate, parser.SeverityWarning, 8, "AC-5", "AC-5", "", "same narrative as 3 controls: AC-3, AC-4, AC-5"},
+		{RuleFutureTense, parser.SeverityWarning, 9, "AU-2", "AU-2", "", `control is complete but the narrative says what "will" be done`},
+		{RuleProductName, parser.SeverityError, 10, "AU-3", "AU-3", "", `narrative mentions "Red Hat Enterprise Linux"`},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Lint() =\n%v\nwant\n%v", got, want)
+	}
+
+	cfg.Disable(RuleDuplicate)
+	cfg.MinLength = 0
+	if got := Lint(rows, cfg); len(got) != 5 {
+		t.Errorf("Lint() with rules disabled = %v, want 5 findings", got)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		want    Config
+		wantErr bool
+	}{
+		{"defaults", "product: OpenShift\n", Config{Rules: map[string]RuleConfig{}, MinLength: 40, MaxDuplicates: 3, Product: "OpenShift"}, false},
+		{"rules", "min-length: 10\nrules:\n  todo:\n    severity: warning\n", Config{Rules: map[string]RuleConfig{RuleTodo: {Severity: "warning"}}, MinLength: 10, MaxDuplicates: 3}, false},
+		{"unknown rule", "rules:\n  spelling:\n    disabled: true\n", Config{}, true},
+		{"unknown severity", "rules:\n  todo:\n    severity: fatal\n", Config{}, true},
+		{"unknown field", "max-length: 10\n", Config{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "lint.yaml")
+			if err := ioutil.WriteFile(path, []byte(tt.doc), 0644); err != nil {
+				t.Fatal(err)
+			}
+			got, err := LoadConfig(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// ContentType is the content type of the Prometheus text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// componentState is the result of the last ingestion of a component.
+type componentState struct {
+	data        parser.Data
+	errors      int
+	warnings    int
+	lastSuccess time.Time
+	lastFailed  bool
+	failures    int
+}
+
+// Exporter exposes the compliance posture of components as Prometheus
+// metrics. It is safe for concurrent use.
+type Exporter struct {
+	mu         sync.RWMutex
+	components map[string]*componentState
+}
+
+// NewExporter returns an exporter without components.
+func NewExporter() *Exporter {
+	return &Exporter{components: make(map[string]*componentState)}
+}
+
+func (e *Exporter) state(component string) *componentState {
+	s, ok := e.components[component]
+	if !ok {
+		s = &componentState{}
+		e.components[component] = s
+	}
+	return s
+}
+
+// Update records a successful ingestion of a component.
+func (e *Exporter) Update(component string, data parser.Data, diags []parser.Diagnostic, at time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	s := e.state(component)
+	s.data = data
+	s.errors, s.warnings = 0, 0
+	for _, d := range diags {
+		if d.Severity == parser.SeverityError {
+			s.errors++
+		} else {
+			s.warnings++
+		}
+	}
+	s.lastSuccess = at
+	s.lastFailed = false
+}
+
+// Fail records a failed ingestion of a component, e.g. when the sheet
+// couldn't be read. The metrics of the last successful ingestion are kept.
+func (e *Exporter) Fail(component string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	s := e.state(component)
+	s.lastFailed = true
+	s.failures++
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", ContentType)
+	e.WriteTo(w)
+}
+
+// metric is a metric family with its samples.
+type metric struct {
+	name    string
+	help    string
+	kind    string
+	samples []sample
+}
+
+type sample struct {
+	labels [][2]string
+	value  float64
+}
+
+// WriteTo writes the metrics in the Prometheus text format.
+func (e *Exporter) WriteTo(w io.Writer) (int64, error) {
+	e.mu.RLock()
+	metrics := e.collect()
+	e.mu.RUnlock()
+
+	var n int64
+	for _, m := range metrics {
+		c, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", m.name, m.help, m.name, m.kind)
+		n += int64(c)
+		if err != nil {
+			return n, err
+		}
+		for _, s := range m.samples {
+			c, err := fmt.Fprintf(w, "%s%s %s\n", m.name, formatLabels(s.labels), formatValue(s.value))
+			n += int64(c)
+			if err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (e *Exporter) collect() []metric {
+	controls := metric{
+		name: "autocmp_controls_total",
+		help: "Number of controls and enhancements per family and implementation status.",
+		kind: "gauge",
+	}
+	completeness := metric{
+		name: "autocmp_narrative_completeness_ratio",
+		help: "Ratio of narratives with real text rather than placeholders.",
+		kind: "gauge",
+	}
+	parseErrors := metric{
+		name: "autocmp_parse_errors_total",
+		help: "Number of rows that couldn't be parsed in the last successful sync.",
+		kind: "gauge",
+	}
+	parseWarnings := metric{
+		name: "autocmp_parse_warnings_total",
+		help: "Number of parse warnings in the last successful sync.",
+		kind: "gauge",
+	}
+	lastSync := metric{
+		name: "autocmp_last_successful_sync_timestamp_seconds",
+		help: "Unix time of the last successful sync.",
+		kind: "gauge",
+	}
+	syncUp := metric{
+		name: "autocmp_sync_up",
+		help: "Whether the last sync succeeded.",
+		kind: "gauge",
+	}
+	failures := metric{
+		name: "autocmp_sync_failures_total",
+		help: "Number of failed syncs since the start of the process.",
+		kind: "counter",
+	}
+
+	var names []string
+	for name := range e.components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		s := e.components[name]
+		comp := [2]string{"component", name}
+
+		if !s.lastSuccess.IsZero() {
+			controls.samples = append(controls.samples, controlSamples(comp, s.data)...)
+			completeness.samples = append(completeness.samples, completenessSamples(comp, s.data)...)
+			parseErrors.samples = append(parseErrors.samples, sample{[][2]string{comp}, float64(s.errors)})
+			parseWarnings.samples = append(parseWarnings.samples, sample{[][2]string{comp}, float64(s.warnings)})
+			lastSync.samples = append(lastSync.samples, sample{[][2]string{comp}, float64(s.lastSuccess.Unix())})
+		}
+		up := 1.0
+		if s.lastFailed {
+			up = 0
+		}
+		syncUp.samples = append(syncUp.samples, sample{[][2]string{comp}, up})
+		failures.samples = append(failures.samples, sample{[][2]string{comp}, float64(s.failures)})
+	}
+	return []metric{controls, completeness, parseErrors, parseWarnings, lastSync, syncUp, failures}
+}
+
+func controlSamples(comp [2]string, data parser.Data) []sample {
+	counts := make(map[[2]string]int)
+	for family, ctrls := range data {
+		for _, ctrl := range ctrls {
+			status := ctrl.ImplementationStatus
+			if status == "" {
+				status = "unset"
+			}
+			counts[[2]string{string(family), status}]++
+		}
+	}
+
+	var samples []sample
+	for k, n := range counts {
+		samples = append(samples, sample{
+			labels: [][2]string{comp, {"family", k[0]}, {"status", k[1]}},
+			value:  float64(n),
+		})
+	}
+	sortSamples(samples)
+	return samples
+}
+
+func completenessSamples(comp [2]string, data parser.Data) []sample {
+	var samples []sample
+	for family, ctrls := range data {
+		total, real := 0, 0
+		for _, ctrl := range ctrls {
+			for _, n := range ctrl.Narrative {
+				total++
+				if !parser.IsPlaceholder(n.Text) {
+					real++
+				}
+			}
+		}
+		ratio := 0.0
+		if total > 0 {
+			ratio = float64(real) / float64(total)
+		}
+		samples = append(samples, sample{
+			labels: [][2]string{comp, {"family", string(family)}},
+			value:  ratio,
+		})
+	}
+	sortSamples(samples)
+	return samples
+}
+
+func sortSamples(samples []sample) {
+	sort.Slice(samples, func(i, j int) bool {
+		return formatLabels(samples[i].labels) < formatLabels(samples[j].labels)
+	})
+}
+
+func formatLabels(labels [][2]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	parts := make([]string, len(labels))
+	for i, l := range labels {
+		parts[i] = fmt.Sprintf("%s=\"%s\"", l[0], escapeLabel(l[1]))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(v string) string {
+	return labelEscaper.Replace(v)
+}
+
+func formatValue(v float64) string {
+	return fmt.Sprintf("%g", v)
+}
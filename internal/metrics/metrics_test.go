@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+func TestExporter(t *testing.T) {
+	e := NewExporter()
+	e.Update("rhacm", parser.Data{
+		"AC-Access_Control": {
+			"AC-2": v3c.Satisfies{
+				ControlKey:           "AC-2",
+				ImplementationStatus: parser.StatusComplete,
+				Narrative: []v3c.NarrativeSection{
+					{Key: "a", Text: "Accounts are reviewed"},
+					{Key: "b", Text: parser.PlaceholderEnhancement},
+				},
+			},
+			"AC-3": v3c.Satisfies{ControlKey: "AC-3"},
+		},
+	}, []parser.Diagnostic{
+		{Line: 4, Severity: parser.SeverityError},
+		{Line: 5, Severity: parser.SeverityWarning},
+	}, time.Unix(1700000000, 0))
+	e.Fail("acs \"beta\"")
+
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+	resp, err := srv.Client().Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	if ct := resp.Header.Get("Content-Type"); ct != ContentType {
+		t.Errorf("Content-Type = %q, want %q", ct, ContentType)
+	}
+	want := `# HELP autocmp_controls_total Number of controls and enhancements per family and implementation status.
+# TYPE autocmp_controls_total gauge
+autocmp_controls_total{component="rhacm",family="AC-Access_Control",status="complete"} 1
+autocmp_controls_total{component="rhacm",family="AC-Access_Control",status="unset"} 1
+# HELP autocmp_narrative_completeness_ratio Ratio of narratives with real text rather than placeholders.
+# TYPE autocmp_narrative_completeness_ratio gauge
+autocmp_narrative_completeness_ratio{component="rhacm",family="AC-Access_Control"} 0.5
+# HELP autocmp_parse_errors_total Number of rows that couldn't be parsed in the last successful sync.
+# TYPE autocmp_parse_errors_total gauge
+autocmp_parse_errors_total{component="rhacm"} 1
+# HELP autocmp_parse_warnings_total Number of parse warnings in the last successful sync.
+# TYPE autocmp_parse_warnings_total gauge
+autocmp_parse_warnings_total{component="rhacm"} 1
+# HELP autocmp_last_successful_sync_timestamp_seconds Unix time of the last successful sync.
+# TYPE autocmp_last_successful_sync_timestamp_seconds gauge
+autocmp_last_successful_sync_timestamp_seconds{component="rhacm"} 1.7e+09
+# HELP autocmp_sync_up Whether the last sync succeeded.
+# TYPE autocmp_sync_up gauge
+autocmp_sync_up{component="acs \"beta\""} 0
+autocmp_sync_up{component="rhacm"} 1
+# HELP autocmp_sync_failures_total Number of failed syncs since the start of the process.
+# TYPE autocmp_sync_failures_total counter
+autocmp_sync_failures_total{component="acs \"beta\""} 1
+autocmp_sync_failures_total{component="rhacm"} 0
+`
+	if string(body) != want {
+		t.Errorf("Exporter output =\n%s\nwant\n%s", body, want)
+	}
+}
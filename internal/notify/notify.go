@@ -0,0 +1,262 @@
+// Package notify sends a digest of what went wrong since the previous run to
+// webhooks and by email, routing the items of each owner to their own
+// recipients.
+package notify
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/carlosmmatos/automate-compliance/internal/history"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+	"github.com/carlosmmatos/automate-compliance/internal/report"
+	"github.com/carlosmmatos/automate-compliance/internal/source"
+)
+
+// Digest is what is notified after a run.
+type Digest struct {
+	Product string    `json:"product"`
+	Time    time.Time `json:"time"`
+	// Errors are the rows that couldn't be parsed and could in the previous
+	// run
+	Errors      []parser.Diagnostic  `json:"errors"`
+	Regressions []history.Regression `json:"regressions"`
+	// Overdue are the controls that became overdue since the previous run
+	Overdue []report.OverdueControl `json:"overdue"`
+
+	// owners maps control keys to their owner
+	owners map[string]string
+}
+
+// NewDigest compares a run with the previous one, nil for the first run of a
+// product, in which case all the errors and overdue controls are new.
+func NewDigest(product string, now time.Time, res source.Result, prev *history.Snapshot, grace report.Grace) Digest {
+	d := Digest{
+		Product:     product,
+		Time:        now,
+		Errors:      []parser.Diagnostic{},
+		Regressions: []history.Regression{},
+		Overdue:     []report.OverdueControl{},
+		owners:      make(map[string]string),
+	}
+	for key, details := range res.Details {
+		if details.Owner != "" {
+			d.owners[key] = details.Owner
+		}
+	}
+
+	known := make(map[string]bool)
+	var wasOverdue map[string]bool
+	if prev != nil {
+		for _, e := range prev.Errors {
+			known[e.Control+"\x00"+e.Message] = true
+		}
+		d.Regressions = append(d.Regressions, history.Regressions(*prev, history.NewSnapshot(now, "", "", res.Data))...)
+		wasOverdue = make(map[string]bool)
+		for _, c := range report.NewOverdue(res.Data, res.Details, prev.Timestamp, grace).Controls {
+			wasOverdue[c.ControlKey] = true
+		}
+	}
+	// rows move around, so errors are told apart by control and message only
+	for _, e := range res.Errors() {
+		if !known[e.Control+"\x00"+e.Message] {
+			d.Errors = append(d.Errors, e)
+		}
+	}
+	for _, c := range report.NewOverdue(res.Data, res.Details, now, grace).Controls {
+		if !wasOverdue[c.ControlKey] {
+			d.Overdue = append(d.Overdue, c)
+		}
+	}
+	return d
+}
+
+// Empty tells whether there is nothing to notify.
+func (d Digest) Empty() bool {
+	return len(d.Errors)+len(d.Regressions)+len(d.Overdue) == 0
+}
+
+// Owner returns the items of the digest about the controls of an owner. The
+// rows that couldn't be parsed go to the owner in their owner cell.
+func (d Digest) Owner(owner string) Digest {
+	od := d
+	od.Errors = []parser.Diagnostic{}
+	od.Regressions = []history.Regression{}
+	od.Overdue = []report.OverdueControl{}
+	for _, e := range d.Errors {
+		if e.Owner == owner {
+			od.Errors = append(od.Errors, e)
+		}
+	}
+	for _, r := range d.Regressions {
+		if d.owners[r.ControlKey] == owner {
+			od.Regressions = append(od.Regressions, r)
+		}
+	}
+	for _, c := range d.Overdue {
+		if c.Owner == owner {
+			od.Overdue = append(od.Overdue, c)
+		}
+	}
+	return od
+}
+
+// Subject summarizes the digest in a line.
+func (d Digest) Subject() string {
+	return fmt.Sprintf("[autocmp] %s: %d new errors, %d regressions, %d newly overdue",
+		d.Product, len(d.Errors), len(d.Regressions), len(d.Overdue))
+}
+
+// Text writes the digest as plain text, which Slack renders as is.
+func (d Digest) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s on %s\n", d.Subject(), d.Time.UTC().Format("2006-01-02 15:04 MST"))
+	if len(d.Errors) > 0 {
+		fmt.Fprintf(&b, "\nNew parse errors:\n")
+		for _, e := range d.Errors {
+			fmt.Fprintf(&b, "- %s\n", e)
+		}
+	}
+	if len(d.Regressions) > 0 {
+		fmt.Fprintf(&b, "\nStatus regressions:\n")
+		for _, r := range d.Regressions {
+			fmt.Fprintf(&b, "- %s: %s -> %s\n", r.ControlKey, r.From, r.To)
+		}
+	}
+	if len(d.Overdue) > 0 {
+		fmt.Fprintf(&b, "\nNewly overdue:\n")
+		for _, c := range d.Overdue {
+			owner := c.Owner
+			if owner == "" {
+				owner = report.Unassigned
+			}
+			fmt.Fprintf(&b, "- %s (%s, %s): due %s, %d days late\n", c.ControlKey, c.Status, owner, c.Date, c.DaysLate)
+		}
+	}
+	return b.String()
+}
+
+// Route lists where the digests of an owner go.
+type Route struct {
+	Webhook string   `yaml:"webhook"`
+	Email   []string `yaml:"email"`
+}
+
+// SMTPConfig describes the mail server the digests are sent through.
+type SMTPConfig struct {
+	// Server is the host:port of the mail server
+	Server   string `yaml:"server"`
+	From     string `yaml:"from"`
+	Username string `yaml:"username"`
+	// PasswordEnv is the environment variable holding the password, so that
+	// it stays out of the configuration file
+	PasswordEnv string `yaml:"password-env"`
+}
+
+// Config is the notification configuration file.
+type Config struct {
+	// Grace is the number of days a control may stay open past its date
+	// before it is overdue
+	Grace int        `yaml:"grace"`
+	SMTP  SMTPConfig `yaml:"smtp"`
+	// Default receives the whole digest
+	Default Route `yaml:"default"`
+	// Owners receive the items about their controls
+	Owners map[string]Route `yaml:"owners"`
+}
+
+// Check validates the configuration.
+func (c Config) Check() error {
+	if c.Grace < 0 {
+		return fmt.Errorf("grace must not be negative")
+	}
+	needSMTP := len(c.Default.Email) > 0
+	for _, r := range c.Owners {
+		needSMTP = needSMTP || len(r.Email) > 0
+	}
+	if needSMTP && (c.SMTP.Server == "" || c.SMTP.From == "") {
+		return fmt.Errorf("smtp server and from are required to send emails")
+	}
+	if c.SMTP.Server != "" {
+		if _, _, err := net.SplitHostPort(c.SMTP.Server); err != nil {
+			return fmt.Errorf("smtp server: %v", err)
+		}
+	}
+	return nil
+}
+
+// LoadConfig reads a notification configuration file.
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err := yaml.UnmarshalStrict(b, &cfg); err != nil {
+		return cfg, fmt.Errorf("%s: %v", path, err)
+	}
+	if err := cfg.Check(); err != nil {
+		return cfg, fmt.Errorf("%s: %v", path, err)
+	}
+	return cfg, nil
+}
+
+// Notifier sends the digests as configured.
+type Notifier struct {
+	cfg Config
+}
+
+// New returns a notifier for a configuration.
+func New(cfg Config) *Notifier {
+	return &Notifier{cfg: cfg}
+}
+
+// Grace returns the overdue grace period of the configuration.
+func (n *Notifier) Grace() report.Grace {
+	return report.Grace{Days: n.cfg.Grace}
+}
+
+func (n *Notifier) sinks(route Route) []Sink {
+	var sinks []Sink
+	if route.Webhook != "" {
+		sinks = append(sinks, &Webhook{URL: route.Webhook})
+	}
+	if len(route.Email) > 0 {
+		m := &Mail{Server: n.cfg.SMTP.Server, From: n.cfg.SMTP.From, To: route.Email}
+		if n.cfg.SMTP.Username != "" {
+			host, _, _ := net.SplitHostPort(n.cfg.SMTP.Server)
+			m.Auth = smtp.PlainAuth("", n.cfg.SMTP.Username, os.Getenv(n.cfg.SMTP.PasswordEnv), host)
+		}
+		sinks = append(sinks, m)
+	}
+	return sinks
+}
+
+// Send sends the whole digest to the default route and the items of each
+// owner to their route, skipping the empty digests. It tries every sink and
+// returns the first error.
+func (n *Notifier) Send(d Digest) error {
+	var first error
+	send := func(route Route, d Digest) {
+		if d.Empty() {
+			return
+		}
+		for _, s := range n.sinks(route) {
+			if err := s.Send(d); err != nil && first == nil {
+				first = err
+			}
+		}
+	}
+	send(n.cfg.Default, d)
+	for owner, route := range n.cfg.Owners {
+		send(route, d.Owner(owner))
+	}
+	return first
+}
@@ -0,0 +1,313 @@
+package notify
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/carlosmmatos/automate-compliance/internal/history"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+	"github.com/carlosmmatos/automate-compliance/internal/report"
+	"github.com/carlosmmatos/automate-compliance/internal/source"
+)
+
+var (
+	prevTime = time.Date(2026, 9, 20, 8, 0, 0, 0, time.UTC)
+	now      = time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+)
+
+func row(line int, control, status, owner, due string) parser.Row {
+	return parser.Row{Line: line, Family: "ACCESS CONTROL", Control: control, Narrative: "Narrative of " + control, Status: status, Owner: owner, Due: due}
+}
+
+// testDigest compares two runs: AC-2 went from complete to partial, AC-4
+// became overdue while AC-3 already was, the nonsense row is a new error
+// while the garbage row already failed.
+func testDigest() Digest {
+	prevRes := source.ParseRows([]parser.Row{
+		row(2, "AC-2", "Complete", "alice", ""),
+		row(3, "AC-3", "Planned", "bob", "2026-09-01"),
+		row(4, "garbage", "Planned", "bob", ""),
+	})
+	prev := history.NewSnapshot(prevTime, "", "", prevRes.Data)
+	prev.Errors = prevRes.Errors()
+	return NewDigest("acm", now, testResult(), &prev, report.Grace{})
+}
+
+func testResult() source.Result {
+	return source.ParseRows([]parser.Row{
+		row(2, "AC-2", "Partial", "alice", ""),
+		row(3, "AC-3", "Planned", "bob", "2026-09-01"),
+		row(4, "garbage", "Planned", "bob", ""),
+		row(5, "AC-4", "Planned", "alice", "2026-09-25"),
+		row(6, "nonsense", "Planned", "alice", ""),
+		row(7, "AC-5", "Planned", "carol", "soon"),
+	})
+}
+
+func TestNewDigest(t *testing.T) {
+	got := testDigest()
+	want := Digest{
+		Product: "acm",
+		Time:    now,
+		Errors: []parser.Diagnostic{
+			{Line: 6, Control: "nonsense", Severity: parser.SeverityError, Message: "couldn't parse control", Owner: "alice"},
+		},
+		Regressions: []history.Regression{
+			{Timestamp: now, Family: "AC-Access_Control", ControlKey: "AC-2", From: parser.StatusComplete, To: parser.StatusPartial},
+		},
+		// AC-3 was already overdue in the previous run
+		Overdue: []report.OverdueControl{
+			{ControlKey: "AC-4", Owner: "alice", Status: parser.StatusPlanned, Date: "2026-09-25", DaysLate: 6},
+		},
+		owners: map[string]string{"AC-2": "alice", "AC-3": "bob", "AC-4": "alice", "AC-5": "carol"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewDigest() = %+v, want %+v", got, want)
+	}
+
+	first := NewDigest("acm", now, testResult(), nil, report.Grace{})
+	if len(first.Errors) != 2 || len(first.Regressions) != 0 || len(first.Overdue) != 2 {
+		t.Errorf("NewDigest() of the first run = %+v, want every error and overdue control", first)
+	}
+}
+
+func TestDigest_Owner(t *testing.T) {
+	d := NewDigest("acm", now, testResult(), nil, report.Grace{})
+	tests := []struct {
+		owner                         string
+		errors, regressions, overdues int
+	}{
+		{"alice", 1, 0, 1},
+		{"bob", 1, 0, 1},
+		{"carol", 0, 0, 0},
+	}
+	for _, tt := range tests {
+		got := d.Owner(tt.owner)
+		if len(got.Errors) != tt.errors || len(got.Regressions) != tt.regressions || len(got.Overdue) != tt.overdues {
+			t.Errorf("Owner(%q) = %+v, want %d errors, %d regressions, %d overdue", tt.owner, got, tt.errors, tt.regressions, tt.overdues)
+		}
+		if got.Empty() != (tt.errors+tt.regressions+tt.overdues == 0) {
+			t.Errorf("Owner(%q).Empty() = %v", tt.owner, got.Empty())
+		}
+	}
+	if got := testDigest().Owner("alice"); len(got.Errors) != 1 || len(got.Regressions) != 1 || len(got.Overdue) != 1 {
+		t.Errorf("Owner(alice) = %+v, want the nonsense row, AC-2 and AC-4", got)
+	}
+}
+
+func TestDigest_Text(t *testing.T) {
+	want := `[autocmp] acm: 1 new errors, 1 regressions, 1 newly overdue on 2026-10-01 08:00 UTC
+
+New parse errors:
+- row 6: error: nonsense: couldn't parse control
+
+Status regressions:
+- AC-2: complete -> partial
+
+Newly overdue:
+- AC-4 (planned, alice): due 2026-09-25, 6 days late
+`
+	if got := testDigest().Text(); got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+}
+
+// webhookServer records the payloads posted to it.
+func webhookServer(t *testing.T, payloads chan<- webhookPayload) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p webhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			t.Errorf("decoding webhook payload: %v", err)
+		}
+		payloads <- p
+	}))
+}
+
+func TestWebhook(t *testing.T) {
+	payloads := make(chan webhookPayload, 1)
+	srv := webhookServer(t, payloads)
+	defer srv.Close()
+
+	d := testDigest()
+	if err := (&Webhook{URL: srv.URL}).Send(d); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	p := <-payloads
+	if p.Text != d.Text() {
+		t.Errorf("text = %q, want %q", p.Text, d.Text())
+	}
+	if !reflect.DeepEqual(p.Digest.Regressions, d.Regressions) {
+		t.Errorf("digest regressions = %+v, want %+v", p.Digest.Regressions, d.Regressions)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no_service", http.StatusNotFound)
+	}))
+	defer failing.Close()
+	if err := (&Webhook{URL: failing.URL}).Send(d); err == nil {
+		t.Errorf("Send() to a failing webhook succeeded")
+	}
+}
+
+// smtpMessage is a message received by smtpServer.
+type smtpMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+// smtpServer accepts a single SMTP session on a local port and sends the
+// message it receives on the channel.
+func smtpServer(t *testing.T, messages chan<- smtpMessage) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		defer l.Close()
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		reply := func(s string) { conn.Write([]byte(s + "\r\n")) }
+		var msg smtpMessage
+		reply("220 localhost ESMTP")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			cmd := strings.ToUpper(line)
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL FROM:"):
+				msg.from = strings.Trim(line[len("MAIL FROM:"):], "<>")
+				reply("250 OK")
+			case strings.HasPrefix(cmd, "RCPT TO:"):
+				msg.to = append(msg.to, strings.Trim(line[len("RCPT TO:"):], "<>"))
+				reply("250 OK")
+			case cmd == "DATA":
+				reply("354 end with .")
+				var data strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if l == ".\r\n" {
+						break
+					}
+					data.WriteString(l)
+				}
+				msg.data = data.String()
+				reply("250 OK")
+				messages <- msg
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+	return l.Addr().String()
+}
+
+func TestMail(t *testing.T) {
+	messages := make(chan smtpMessage, 1)
+	addr := smtpServer(t, messages)
+
+	d := testDigest()
+	m := &Mail{Server: addr, From: "autocmp@example.com", To: []string{"alice@example.com", "bob@example.com"}}
+	if err := m.Send(d); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	msg := <-messages
+	if msg.from != m.From || !reflect.DeepEqual(msg.to, m.To) {
+		t.Errorf("envelope = %s to %v, want %s to %v", msg.from, msg.to, m.From, m.To)
+	}
+	for _, want := range []string{
+		"Subject: " + d.Subject() + "\r\n",
+		"To: alice@example.com, bob@example.com\r\n",
+		"- AC-2: complete -> partial\r\n",
+	} {
+		if !strings.Contains(msg.data, want) {
+			t.Errorf("message %q doesn't contain %q", msg.data, want)
+		}
+	}
+}
+
+func TestNotifier_Send(t *testing.T) {
+	all := make(chan webhookPayload, 2)
+	defaultSrv := webhookServer(t, all)
+	defer defaultSrv.Close()
+	alice := make(chan webhookPayload, 2)
+	aliceSrv := webhookServer(t, alice)
+	defer aliceSrv.Close()
+	bob := make(chan webhookPayload, 2)
+	bobSrv := webhookServer(t, bob)
+	defer bobSrv.Close()
+
+	n := New(Config{
+		Default: Route{Webhook: defaultSrv.URL},
+		Owners: map[string]Route{
+			"alice": {Webhook: aliceSrv.URL},
+			"bob":   {Webhook: bobSrv.URL},
+		},
+	})
+	if err := n.Send(testDigest()); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if len(all) != 1 {
+		t.Errorf("default route got %d digests, want 1", len(all))
+	}
+	if len(alice) != 1 {
+		t.Fatalf("alice got %d digests, want 1", len(alice))
+	}
+	if p := <-alice; len(p.Digest.Errors) != 1 || len(p.Digest.Overdue) != 1 {
+		t.Errorf("alice got %+v, want the items of AC-2 and AC-4", p.Digest)
+	}
+	// bob has nothing new
+	if len(bob) != 0 {
+		t.Errorf("bob got %d digests, want none", len(bob))
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"webhooks", "default:\n  webhook: https://hooks.example.com/x\nowners:\n  alice:\n    webhook: https://hooks.example.com/a\n", false},
+		{"email", "smtp:\n  server: mail.example.com:587\n  from: autocmp@example.com\nowners:\n  alice:\n    email: [alice@example.com]\n", false},
+		{"email without server", "default:\n  email: [team@example.com]\n", true},
+		{"server without port", "smtp:\n  server: mail.example.com\n  from: autocmp@example.com\n", true},
+		{"unknown field", "default:\n  slack: https://hooks.example.com/x\n", true},
+		{"negative grace", "grace: -1\n", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "notify.yaml")
+			if err := ioutil.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadConfig(path); (err != nil) != tt.wantErr {
+				t.Errorf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Sink delivers digests.
+type Sink interface {
+	Send(d Digest) error
+}
+
+// Webhook posts digests as JSON. The text field makes the payload a Slack
+// incoming webhook message; other receivers can use the digest field.
+type Webhook struct {
+	URL string
+	// Client defaults to a client with a 30 seconds timeout
+	Client *http.Client
+}
+
+type webhookPayload struct {
+	Text   string `json:"text"`
+	Digest Digest `json:"digest"`
+}
+
+// Send posts a digest to the webhook.
+func (w *Webhook) Send(d Digest) error {
+	b, err := json.Marshal(webhookPayload{Text: d.Text(), Digest: d})
+	if err != nil {
+		return err
+	}
+	client := w.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	resp, err := client.Post(w.URL, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook %s: %s", w.URL, resp.Status)
+	}
+	return nil
+}
+
+// Mail sends digests by email.
+type Mail struct {
+	// Server is the host:port of the mail server
+	Server string
+	From   string
+	To     []string
+	// Auth is nil when the server doesn't require authentication
+	Auth smtp.Auth
+}
+
+// Send emails a digest.
+func (m *Mail) Send(d Digest) error {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", m.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(m.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", d.Subject())
+	fmt.Fprintf(&b, "Date: %s\r\n", d.Time.Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&b, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(strings.Replace(d.Text(), "\n", "\r\n", -1))
+	if err := smtp.SendMail(m.Server, m.Auth, m.From, m.To, b.Bytes()); err != nil {
+		return fmt.Errorf("smtp %s: %v", m.Server, err)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package opencontrol
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/blang/semver"
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+	"gopkg.in/yaml.v2"
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// SchemaVersion is the component.yaml schema version we generate.
+const SchemaVersion = "3.1.0"
+
+// componentFile mirrors v3c.Component but omits empty fields, so that the
+// files we write stay close to what a person would write by hand.
+type componentFile struct {
+	SchemaVersion   string                        `yaml:"schema_version"`
+	Name            string                        `yaml:"name"`
+	Key             string                        `yaml:"key,omitempty"`
+	ResponsibleRole string                        `yaml:"responsible_role,omitempty"`
+	References      common.GeneralReferences      `yaml:"references,omitempty"`
+	Verifications   common.VerificationReferences `yaml:"verifications,omitempty"`
+	Satisfies       []satisfiesFile               `yaml:"satisfies"`
+}
+
+// satisfiesFile mirrors v3c.Satisfies, see componentFile.
+type satisfiesFile struct {
+	ControlKey             string                 `yaml:"control_key"`
+	StandardKey            string                 `yaml:"standard_key,omitempty"`
+	Narrative              []v3c.NarrativeSection `yaml:"narrative,omitempty"`
+	CoveredBy              common.CoveredByList   `yaml:"covered_by,omitempty"`
+	Parameters             []v3c.Section          `yaml:"parameters,omitempty"`
+	ControlOrigin          string                 `yaml:"control_origin,omitempty"`
+	ControlOrigins         []string               `yaml:"control_origins,omitempty"`
+	ImplementationStatus   string                 `yaml:"implementation_status,omitempty"`
+	ImplementationStatuses []string               `yaml:"implementation_statuses,omitempty"`
+	// ResponsibleRole is the owner of the control, see Owners
+	ResponsibleRole string `yaml:"responsible_role,omitempty"`
+}
+
+// NewComponent builds a component out of the parsed sheet data. Controls are
+// sorted by control key and narratives by narrative key so the output is
+// stable regardless of the order of the rows in the sheet.
+func NewComponent(name, key, standard string, data parser.Data) *v3c.Component {
+	c := &v3c.Component{
+		Name:          name,
+		Key:           key,
+		SchemaVersion: semver.MustParse(SchemaVersion),
+	}
+	for _, ctrls := range data {
+		for _, ctrl := range ctrls {
+			ctrl.StandardKey = standard
+			ctrl.Narrative = append([]v3c.NarrativeSection(nil), ctrl.Narrative...)
+			SortNarratives(ctrl.Narrative)
+			c.Satisfies = append(c.Satisfies, ctrl)
+		}
+	}
+	SortSatisfies(c.Satisfies)
+	return c
+}
+
+// SortSatisfies sorts controls using the natural order of their keys, so
+// AC-2 (2) comes before AC-2 (10).
+func SortSatisfies(s []v3c.Satisfies) {
+	sort.SliceStable(s, func(i, j int) bool {
+		return sortorder.NaturalLess(s[i].ControlKey, s[j].ControlKey)
+	})
+}
+
+// SortNarratives sorts narrative sections using the natural order of their
+// keys. A section without key always goes first.
+func SortNarratives(n []v3c.NarrativeSection) {
+	sort.SliceStable(n, func(i, j int) bool {
+		return sortorder.NaturalLess(n[i].Key, n[j].Key)
+	})
+}
+
+// Owners maps control keys to the person or team accountable for them.
+// OpenControl only has a responsible role per component, so the owners are
+// written as an extra responsible_role of the satisfies entries, which
+// compliance-masonry ignores.
+type Owners map[string]string
+
+// NewOwners returns the owners of the controls that have one.
+func NewOwners(details map[string]parser.Details) Owners {
+	o := make(Owners)
+	for key, d := range details {
+		if d.Owner != "" {
+			o[key] = d.Owner
+		}
+	}
+	return o
+}
+
+// ResponsibleRole returns the owner of the most controls, used as the
+// responsible role of the component. Ties go to the first owner in
+// alphabetical order.
+func (o Owners) ResponsibleRole() string {
+	counts := make(map[string]int)
+	for _, owner := range o {
+		counts[owner]++
+	}
+	role := ""
+	for owner, n := range counts {
+		if n > counts[role] || (n == counts[role] && owner < role) {
+			role = owner
+		}
+	}
+	return role
+}
+
+// LoadOwners reads the owners of the controls of a component.yaml file.
+func LoadOwners(path string) (Owners, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f struct {
+		Satisfies []satisfiesFile `yaml:"satisfies"`
+	}
+	if err := yaml.Unmarshal(b, &f); err != nil {
+		return nil, err
+	}
+	o := make(Owners)
+	for _, s := range f.Satisfies {
+		if s.ResponsibleRole != "" {
+			o[s.ControlKey] = s.ResponsibleRole
+		}
+	}
+	return o, nil
+}
+
+// LoadComponent reads a component.yaml file.
+func LoadComponent(path string) (*v3c.Component, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &v3c.Component{}
+	if err := yaml.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+
+	// The schema version isn't part of v3c.Component's yaml mapping
+	var header struct {
+		SchemaVersion string `yaml:"schema_version"`
+	}
+	if err := yaml.Unmarshal(b, &header); err != nil {
+		return nil, err
+	}
+	if header.SchemaVersion != "" {
+		v, err := semver.ParseTolerant(header.SchemaVersion)
+		if err != nil {
+			return nil, err
+		}
+		c.SchemaVersion = v
+	}
+	return c, nil
+}
+
+// WriteComponent writes a component.yaml file, creating its directory if
+// needed. Controls are written in the order they have in the component,
+// along with their owner if any.
+//
+// Files are written in a canonical form, see MarshalComponent: rewriting a
+// file in that form only changes the lines of the fields that changed, but
+// comments, unknown fields and the key order of a file written by hand are
+// lost (see Canonical).
+func WriteComponent(path string, c *v3c.Component, owners Owners) error {
+	b, err := MarshalComponent(c, owners)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+// MarshalComponent returns the YAML representation of a component and the
+// owners of its controls, which may be nil. The keys are in the order of
+// componentFile, empty fields are omitted, and there are no comments.
+func MarshalComponent(c *v3c.Component, owners Owners) ([]byte, error) {
+	f := componentFile{
+		SchemaVersion:   SchemaVersion,
+		Name:            c.Name,
+		Key:             c.Key,
+		ResponsibleRole: c.ResponsibleRole,
+		References:      c.References,
+		Verifications:   c.Verifications,
+		Satisfies:       make([]satisfiesFile, 0, len(c.Satisfies)),
+	}
+	if c.SchemaVersion.Major != 0 {
+		f.SchemaVersion = c.SchemaVersion.String()
+	}
+	for _, s := range c.Satisfies {
+		f.Satisfies = append(f.Satisfies, satisfiesFile{
+			ControlKey:             s.ControlKey,
+			StandardKey:            s.StandardKey,
+			Narrative:              s.Narrative,
+			CoveredBy:              s.CoveredBy,
+			Parameters:             s.Parameters,
+			ControlOrigin:          s.ControlOrigin,
+			ControlOrigins:         s.ControlOrigins,
+			ImplementationStatus:   s.ImplementationStatus,
+			ImplementationStatuses: s.ImplementationStatuses,
+			ResponsibleRole:        owners[s.ControlKey],
+		})
+	}
+	return yaml.Marshal(f)
+}
+
+// Canonical reports whether a component.yaml file is in the form written by
+// WriteComponent, so that rewriting it keeps everything but the changes.
+func Canonical(path string) (bool, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	c, err := LoadComponent(path)
+	if err != nil {
+		return false, err
+	}
+	owners, err := LoadOwners(path)
+	if err != nil {
+		return false, err
+	}
+	canonical, err := MarshalComponent(c, owners)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(b, canonical), nil
+}
@@ -0,0 +1,162 @@
+package opencontrol
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+func TestNewComponent(t *testing.T) {
+	data := parser.Data{
+		"AC-Access_Control": {
+			"AC-10":    buildControl("AC-10", ""),
+			"AC-2":     buildControl("AC-2", "", narrative("b", "b"), narrative("a", "a")),
+			"AC-2 (1)": buildControl("AC-2 (1)", ""),
+		},
+		"AU-Audit_and_Accountability": {
+			"AU-2": buildControl("AU-2", ""),
+		},
+	}
+
+	got := NewComponent("RHACM", "rhacm", "", data)
+
+	var keys []string
+	for _, s := range got.Satisfies {
+		keys = append(keys, s.ControlKey)
+	}
+	if want := []string{"AC-2", "AC-2 (1)", "AC-10", "AU-2"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("NewComponent() control keys = %v, want %v", keys, want)
+	}
+	if want := []v3c.NarrativeSection{narrative("a", "a"), narrative("b", "b")}; !reflect.DeepEqual(got.Satisfies[0].Narrative, want) {
+		t.Errorf("NewComponent() narratives = %v, want %v", got.Satisfies[0].Narrative, want)
+	}
+}
+
+func TestWriteComponent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "component", "component.yaml")
+	c := NewComponent("RHACM", "rhacm", "NIST-800-53", parser.Data{
+		"AC-Access_Control": {
+			"AC-2": buildControl("AC-2", "complete", narrative("a", "Accounts are managed")),
+		},
+	})
+
+	if err := WriteComponent(path, c, nil); err != nil {
+		t.Fatalf("WriteComponent() error = %v", err)
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `schema_version: 3.1.0
+name: RHACM
+key: rhacm
+satisfies:
+- control_key: AC-2
+  standard_key: NIST-800-53
+  narrative:
+  - key: a
+    text: Accounts are managed
+  implementation_status: complete
+`
+	if string(b) != want {
+		t.Errorf("WriteComponent() wrote\n%s\nwant\n%s", b, want)
+	}
+
+	got, err := LoadComponent(path)
+	if err != nil {
+		t.Fatalf("LoadComponent() error = %v", err)
+	}
+	if !reflect.DeepEqual(got.Satisfies, c.Satisfies) || got.SchemaVersion.String() != SchemaVersion {
+		t.Errorf("LoadComponent() = %v, want %v", got, c)
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "component.yaml")
+	c := NewComponent("RHACM", "", "NIST-800-53", parser.Data{
+		"AC-Access_Control": {
+			"AC-2": buildControl("AC-2", "complete", narrative("a", "Accounts are managed")),
+			"AC-3": buildControl("AC-3", "planned", narrative("a", "Access is enforced")),
+		},
+	})
+	if err := WriteComponent(path, c, Owners{"AC-2": "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	before, _ := ioutil.ReadFile(path)
+	if ok, err := Canonical(path); !ok || err != nil {
+		t.Errorf("Canonical() of a written file = %v, %v, want true", ok, err)
+	}
+
+	// rewriting a canonical file only changes what changed
+	c.Satisfies[1].ImplementationStatus = "partial"
+	if err := WriteComponent(path, c, Owners{"AC-2": "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	after, _ := ioutil.ReadFile(path)
+	oldLines, newLines := strings.Split(string(before), "\n"), strings.Split(string(after), "\n")
+	var changed []string
+	for i := range oldLines {
+		if i < len(newLines) && oldLines[i] != newLines[i] {
+			changed = append(changed, newLines[i])
+		}
+	}
+	if len(oldLines) != len(newLines) || !reflect.DeepEqual(changed, []string{"  implementation_status: partial"}) {
+		t.Errorf("rewriting changed %q, want the status of AC-3 only", changed)
+	}
+
+	commented := "# maintained by the compliance team\n" + string(after)
+	if err := ioutil.WriteFile(path, []byte(commented), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := Canonical(path); ok || err != nil {
+		t.Errorf("Canonical() of a commented file = %v, %v, want false", ok, err)
+	}
+}
+
+func TestOwners(t *testing.T) {
+	owners := NewOwners(map[string]parser.Details{
+		"AC-2":     {Owner: "platform-team"},
+		"AC-2 (1)": {Owner: "platform-team"},
+		"AC-3":     {Owner: "identity-team"},
+		"AU-2":     {},
+	})
+	if got, want := owners, (Owners{"AC-2": "platform-team", "AC-2 (1)": "platform-team", "AC-3": "identity-team"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewOwners() = %v, want %v", got, want)
+	}
+	if got := owners.ResponsibleRole(); got != "platform-team" {
+		t.Errorf("Owners.ResponsibleRole() = %q, want platform-team", got)
+	}
+	if got := (Owners{"AC-2": "b", "AC-3": "a"}).ResponsibleRole(); got != "a" {
+		t.Errorf("Owners.ResponsibleRole() of a tie = %q, want a", got)
+	}
+
+	path := filepath.Join(t.TempDir(), "component.yaml")
+	c := NewComponent("RHACM", "", "", parser.Data{
+		"AC-Access_Control": {
+			"AC-3": buildControl("AC-3", ""),
+			"AC-4": buildControl("AC-4", ""),
+		},
+	})
+	c.ResponsibleRole = owners.ResponsibleRole()
+	if err := WriteComponent(path, c, owners); err != nil {
+		t.Fatalf("WriteComponent() error = %v", err)
+	}
+	got, err := LoadOwners(path)
+	if err != nil {
+		t.Fatalf("LoadOwners() error = %v", err)
+	}
+	if want := (Owners{"AC-3": "identity-team"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("LoadOwners() = %v, want %v", got, want)
+	}
+	loaded, err := LoadComponent(path)
+	if err != nil || loaded.ResponsibleRole != "platform-team" {
+		t.Errorf("LoadComponent() responsible role = %q, %v, want platform-team", loaded.ResponsibleRole, err)
+	}
+}
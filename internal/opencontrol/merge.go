@@ -0,0 +1,306 @@
+package opencontrol
+
+import (
+	"fmt"
+	"strings"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+)
+
+// Fields of a control that are owned by the sheet. Anything else in a
+// component (covered_by, parameters, references, ...) is only ever edited by
+// hand and is preserved as is.
+const (
+	FieldControl   = "control"
+	FieldNarrative = "narrative"
+	FieldStatus    = "implementation_status"
+	FieldOrigin    = "control_origin"
+)
+
+// Conflict is a sheet owned field that was changed both in the sheet and by
+// hand since the last sync.
+type Conflict struct {
+	ControlKey   string
+	NarrativeKey string
+	Field        string
+	Base         string
+	Local        string
+	Sheet        string
+}
+
+func (c Conflict) String() string {
+	key := c.ControlKey
+	if c.NarrativeKey != "" {
+		key = fmt.Sprintf("%s (%s)", key, c.NarrativeKey)
+	}
+	return fmt.Sprintf("%s: %s changed in both the sheet and component", key, c.Field)
+}
+
+// Merge does a three-way merge of the sheet data into a hand edited
+// component. base is the sheet data as of the last sync and may be nil when
+// syncing for the first time.
+//
+// Changes are merged per control key and narrative key. When both sides
+// changed a narrative, the local text is kept with git style conflict
+// markers around both versions so it can be resolved by editing the file.
+// Other conflicting fields keep their local value. The existing order of
+// controls and narratives is kept, and new entries are appended, so the
+// resulting file diff is as small as possible.
+func Merge(base, local, sheet *v3c.Component) (*v3c.Component, []Conflict) {
+	if base == nil {
+		base = &v3c.Component{}
+	}
+	baseCtrls := indexSatisfies(base.Satisfies)
+	sheetCtrls := indexSatisfies(sheet.Satisfies)
+
+	merged := *local
+	merged.Satisfies = nil
+	// a responsible role set by hand wins over the owners of the sheet
+	merged.ResponsibleRole, _ = merge3(base.ResponsibleRole, local.ResponsibleRole, sheet.ResponsibleRole)
+	var conflicts []Conflict
+
+	seen := make(map[string]bool)
+	for _, l := range local.Satisfies {
+		seen[l.ControlKey] = true
+		b, inBase := baseCtrls[l.ControlKey]
+		s, inSheet := sheetCtrls[l.ControlKey]
+
+		switch {
+		case inSheet:
+			m, c := mergeSatisfies(b, l, s)
+			merged.Satisfies = append(merged.Satisfies, m)
+			conflicts = append(conflicts, c...)
+		case !inBase:
+			// added by hand
+			merged.Satisfies = append(merged.Satisfies, l)
+		case sameOwnedFields(b, l):
+			// removed from the sheet and untouched locally
+		default:
+			merged.Satisfies = append(merged.Satisfies, l)
+			conflicts = append(conflicts, Conflict{
+				ControlKey: l.ControlKey,
+				Field:      FieldControl,
+				Base:       "present",
+				Local:      "modified",
+				Sheet:      "removed",
+			})
+		}
+	}
+
+	var added []v3c.Satisfies
+	for _, s := range sheet.Satisfies {
+		if seen[s.ControlKey] {
+			continue
+		}
+		if b, ok := baseCtrls[s.ControlKey]; ok && sameOwnedFields(b, s) {
+			// removed by hand and unchanged in the sheet
+			continue
+		}
+		added = append(added, s)
+	}
+	SortSatisfies(added)
+	merged.Satisfies = append(merged.Satisfies, added...)
+
+	return &merged, conflicts
+}
+
+func mergeSatisfies(base, local, sheet v3c.Satisfies) (v3c.Satisfies, []Conflict) {
+	var conflicts []Conflict
+	merged := local
+
+	field := func(name, b, l, s string) string {
+		v, ok := merge3(b, l, s)
+		if !ok {
+			conflicts = append(conflicts, Conflict{
+				ControlKey: local.ControlKey,
+				Field:      name,
+				Base:       b,
+				Local:      l,
+				Sheet:      s,
+			})
+		}
+		return v
+	}
+	merged.ImplementationStatus = field(FieldStatus, base.ImplementationStatus, local.ImplementationStatus, sheet.ImplementationStatus)
+	merged.ControlOrigin = field(FieldOrigin, base.ControlOrigin, local.ControlOrigin, sheet.ControlOrigin)
+
+	baseNarr := indexNarratives(base.Narrative)
+	sheetNarr := indexNarratives(sheet.Narrative)
+
+	merged.Narrative = nil
+	seen := make(map[string]bool)
+	for _, l := range local.Narrative {
+		seen[l.Key] = true
+		b, inBase := baseNarr[l.Key]
+		s, inSheet := sheetNarr[l.Key]
+
+		switch {
+		case inSheet:
+			text, ok := merge3(b.Text, l.Text, s.Text)
+			if !ok {
+				// markers of a previous run that aren't resolved yet are
+				// kept as is
+				if !hasConflictMarkers(l.Text) {
+					text = conflictText(l.Text, s.Text)
+				}
+				conflicts = append(conflicts, Conflict{
+					ControlKey:   local.ControlKey,
+					NarrativeKey: l.Key,
+					Field:        FieldNarrative,
+					Base:         b.Text,
+					Local:        l.Text,
+					Sheet:        s.Text,
+				})
+			}
+			merged.Narrative = append(merged.Narrative, v3c.NarrativeSection{Key: l.Key, Text: text})
+		case !inBase:
+			merged.Narrative = append(merged.Narrative, l)
+		case b.Text == l.Text:
+			// removed from the sheet and untouched locally
+		default:
+			merged.Narrative = append(merged.Narrative, l)
+			conflicts = append(conflicts, Conflict{
+				ControlKey:   local.ControlKey,
+				NarrativeKey: l.Key,
+				Field:        FieldNarrative,
+				Base:         b.Text,
+				Local:        l.Text,
+			})
+		}
+	}
+
+	var added []v3c.NarrativeSection
+	for _, s := range sheet.Narrative {
+		if seen[s.Key] {
+			continue
+		}
+		if b, ok := baseNarr[s.Key]; ok && b.Text == s.Text {
+			// removed by hand and unchanged in the sheet
+			continue
+		}
+		added = append(added, s)
+	}
+	SortNarratives(added)
+	merged.Narrative = append(merged.Narrative, added...)
+
+	return merged, conflicts
+}
+
+// MergeOwners does a three-way merge of the owners of the sheet into the
+// owners of a hand edited component, per control key like Merge does for the
+// responsible role of the component: an owner set by hand wins over the
+// sheet. base is the owners of the sheet as of the last sync and may be nil.
+func MergeOwners(base, local, sheet Owners) Owners {
+	merged := make(Owners)
+	for _, o := range []Owners{base, local, sheet} {
+		for key := range o {
+			if owner, _ := merge3(base[key], local[key], sheet[key]); owner != "" {
+				merged[key] = owner
+			}
+		}
+	}
+	return merged
+}
+
+// merge3 merges a single value. It returns false when both sides changed the
+// value in different ways, in which case the local value is returned.
+func merge3(base, local, sheet string) (string, bool) {
+	switch {
+	case local == sheet:
+		return local, true
+	case local == base:
+		return sheet, true
+	case sheet == base:
+		return local, true
+	default:
+		return local, false
+	}
+}
+
+func conflictText(local, sheet string) string {
+	return fmt.Sprintf("<<<<<<< component\n%s\n=======\n%s\n>>>>>>> sheet", local, sheet)
+}
+
+func hasConflictMarkers(text string) bool {
+	return strings.HasPrefix(text, "<<<<<<< component\n")
+}
+
+// NextBase returns the sheet data to store as the base of the next merge:
+// the sheet, except for the controls that had conflicts, which keep their
+// base version. Their conflicts are reported again until the component and
+// the sheet agree, instead of the sheet side being dropped. base may be nil.
+func NextBase(base, sheet *v3c.Component, conflicts []Conflict) *v3c.Component {
+	if base == nil {
+		base = &v3c.Component{}
+	}
+	baseCtrls := indexSatisfies(base.Satisfies)
+	conflicting := make(map[string]bool)
+	for _, c := range conflicts {
+		conflicting[c.ControlKey] = true
+	}
+
+	next := *sheet
+	next.Satisfies = nil
+	for _, s := range sheet.Satisfies {
+		if !conflicting[s.ControlKey] {
+			next.Satisfies = append(next.Satisfies, s)
+		}
+	}
+	for key := range conflicting {
+		if b, ok := baseCtrls[key]; ok {
+			next.Satisfies = append(next.Satisfies, b)
+		}
+	}
+	SortSatisfies(next.Satisfies)
+	return &next
+}
+
+// NextBaseOwners returns the owners to store with NextBase: the owners of
+// the sheet, except for the controls that had conflicts, which keep their
+// base owner.
+func NextBaseOwners(base, sheet Owners, conflicts []Conflict) Owners {
+	next := make(Owners)
+	for key, owner := range sheet {
+		next[key] = owner
+	}
+	for _, c := range conflicts {
+		delete(next, c.ControlKey)
+		if owner := base[c.ControlKey]; owner != "" {
+			next[c.ControlKey] = owner
+		}
+	}
+	return next
+}
+
+// sameOwnedFields compares the fields of two controls that are owned by the
+// sheet.
+func sameOwnedFields(a, b v3c.Satisfies) bool {
+	if a.ImplementationStatus != b.ImplementationStatus ||
+		a.ControlOrigin != b.ControlOrigin ||
+		len(a.Narrative) != len(b.Narrative) {
+		return false
+	}
+	an := indexNarratives(a.Narrative)
+	for _, n := range b.Narrative {
+		if o, ok := an[n.Key]; !ok || o.Text != n.Text {
+			return false
+		}
+	}
+	return true
+}
+
+func indexSatisfies(s []v3c.Satisfies) map[string]v3c.Satisfies {
+	idx := make(map[string]v3c.Satisfies, len(s))
+	for _, ctrl := range s {
+		idx[ctrl.ControlKey] = ctrl
+	}
+	return idx
+}
+
+func indexNarratives(n []v3c.NarrativeSection) map[string]v3c.NarrativeSection {
+	idx := make(map[string]v3c.NarrativeSection, len(n))
+	for _, section := range n {
+		idx[section.Key] = section
+	}
+	return idx
+}
@@ -0,0 +1,232 @@
+package opencontrol
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+)
+
+func buildControl(key, status string, n ...v3c.NarrativeSection) v3c.Satisfies {
+	return v3c.Satisfies{
+		ControlKey:           key,
+		ImplementationStatus: status,
+		Narrative:            n,
+	}
+}
+
+func narrative(key, text string) v3c.NarrativeSection {
+	return v3c.NarrativeSection{Key: key, Text: text}
+}
+
+func component(s ...v3c.Satisfies) *v3c.Component {
+	return &v3c.Component{Name: "RHACM", Satisfies: s}
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name          string
+		base          *v3c.Component
+		local         *v3c.Component
+		sheet         *v3c.Component
+		want          *v3c.Component
+		wantConflicts []Conflict
+	}{
+		{
+			"Sheet changes are applied to untouched narratives",
+			component(buildControl("AC-2", "", narrative("a", "old"))),
+			component(buildControl("AC-2", "", narrative("a", "old"))),
+			component(buildControl("AC-2", "complete", narrative("a", "new"))),
+			component(buildControl("AC-2", "complete", narrative("a", "new"))),
+			nil,
+		},
+		{
+			"Hand edits are kept when the sheet didn't change",
+			component(buildControl("AC-2", "", narrative("a", "old"))),
+			component(buildControl("AC-2", "", narrative("a", "polished"))),
+			component(buildControl("AC-2", "", narrative("a", "old"))),
+			component(buildControl("AC-2", "", narrative("a", "polished"))),
+			nil,
+		},
+		{
+			"Fields not owned by the sheet are preserved",
+			component(buildControl("AC-2", "", narrative("a", "old"))),
+			component(v3c.Satisfies{
+				ControlKey: "AC-2",
+				Narrative:  []v3c.NarrativeSection{narrative("a", "old")},
+				CoveredBy:  common.CoveredByList{{VerificationKey: "audit"}},
+			}),
+			component(buildControl("AC-2", "", narrative("a", "new"))),
+			component(v3c.Satisfies{
+				ControlKey: "AC-2",
+				Narrative:  []v3c.NarrativeSection{narrative("a", "new")},
+				CoveredBy:  common.CoveredByList{{VerificationKey: "audit"}},
+			}),
+			nil,
+		},
+		{
+			"Changes on both sides are flagged as conflicts",
+			component(buildControl("AC-2", "planned", narrative("a", "old"))),
+			component(buildControl("AC-2", "partial", narrative("a", "polished"))),
+			component(buildControl("AC-2", "complete", narrative("a", "new"))),
+			component(buildControl("AC-2", "partial", narrative("a", "<<<<<<< component\npolished\n=======\nnew\n>>>>>>> sheet"))),
+			[]Conflict{
+				{ControlKey: "AC-2", Field: FieldStatus, Base: "planned", Local: "partial", Sheet: "complete"},
+				{ControlKey: "AC-2", NarrativeKey: "a", Field: FieldNarrative, Base: "old", Local: "polished", Sheet: "new"},
+			},
+		},
+		{
+			"New controls and narratives are appended in order",
+			component(buildControl("AC-2", "", narrative("b", "old"))),
+			component(buildControl("AC-2", "", narrative("b", "old"))),
+			component(
+				buildControl("AC-10", ""),
+				buildControl("AC-2", "", narrative("a", "new"), narrative("b", "old")),
+				buildControl("AC-3", ""),
+			),
+			component(
+				buildControl("AC-2", "", narrative("b", "old"), narrative("a", "new")),
+				buildControl("AC-3", ""),
+				buildControl("AC-10", ""),
+			),
+			nil,
+		},
+		{
+			"Controls removed from the sheet are removed unless edited by hand",
+			component(buildControl("AC-2", ""), buildControl("AC-3", "", narrative("a", "old"))),
+			component(buildControl("AC-2", ""), buildControl("AC-3", "", narrative("a", "polished")), buildControl("AC-4", "")),
+			component(),
+			component(buildControl("AC-3", "", narrative("a", "polished")), buildControl("AC-4", "")),
+			[]Conflict{
+				{ControlKey: "AC-3", Field: FieldControl, Base: "present", Local: "modified", Sheet: "removed"},
+			},
+		},
+		{
+			"Controls removed by hand stay removed",
+			component(buildControl("AC-2", ""), buildControl("AC-3", "")),
+			component(buildControl("AC-2", "")),
+			component(buildControl("AC-2", ""), buildControl("AC-3", "")),
+			component(buildControl("AC-2", "")),
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, conflicts := Merge(tt.base, tt.local, tt.sheet)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Merge() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(conflicts, tt.wantConflicts) {
+				t.Errorf("Merge() conflicts = %v, want %v", conflicts, tt.wantConflicts)
+			}
+		})
+	}
+}
+
+func TestMergeOwners(t *testing.T) {
+	tests := []struct {
+		name                     string
+		base, local, sheet, want Owners
+	}{
+		{
+			"Owners changed in the sheet are updated",
+			Owners{"AC-2": "alice"},
+			Owners{"AC-2": "alice"},
+			Owners{"AC-2": "bob", "AC-3": "carol"},
+			Owners{"AC-2": "bob", "AC-3": "carol"},
+		},
+		{
+			"Owners set by hand are kept when the sheet has none",
+			Owners{},
+			Owners{"AC-2": "alice", "AC-4": "dave"},
+			Owners{},
+			Owners{"AC-2": "alice", "AC-4": "dave"},
+		},
+		{
+			"Owners changed by hand win over the sheet",
+			Owners{"AC-2": "alice"},
+			Owners{"AC-2": "bob"},
+			Owners{"AC-2": "carol"},
+			Owners{"AC-2": "bob"},
+		},
+		{
+			"Owners removed from the sheet are removed unless changed by hand",
+			Owners{"AC-2": "alice", "AC-3": "alice"},
+			Owners{"AC-2": "alice", "AC-3": "bob"},
+			Owners{},
+			Owners{"AC-3": "bob"},
+		},
+		{
+			"Without a base the local owners win",
+			nil,
+			Owners{"AC-2": "alice"},
+			Owners{"AC-2": "bob", "AC-3": "carol"},
+			Owners{"AC-2": "alice", "AC-3": "carol"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MergeOwners(tt.base, tt.local, tt.sheet); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeOwners() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestMerge_Twice syncs the same sheet twice: conflicts are reported again,
+// without nesting the narrative markers, until they are resolved.
+func TestMerge_Twice(t *testing.T) {
+	base := component(
+		buildControl("AC-2", "planned", narrative("a", "old")),
+		buildControl("AC-3", "planned", narrative("a", "x")),
+	)
+	local := component(
+		buildControl("AC-2", "complete", narrative("a", "polished")),
+		buildControl("AC-3", "planned", narrative("a", "x")),
+	)
+	sheet := component(
+		buildControl("AC-2", "partial", narrative("a", "new")),
+		buildControl("AC-3", "complete", narrative("a", "x")),
+	)
+
+	merged, conflicts := Merge(base, local, sheet)
+	if len(conflicts) != 2 {
+		t.Fatalf("first Merge() conflicts = %v, want the status and narrative of AC-2", conflicts)
+	}
+	next := NextBase(base, sheet, conflicts)
+	wantBase := component(
+		buildControl("AC-2", "planned", narrative("a", "old")),
+		buildControl("AC-3", "complete", narrative("a", "x")),
+	)
+	if !reflect.DeepEqual(next, wantBase) {
+		t.Errorf("NextBase() = %v, want %v", next, wantBase)
+	}
+
+	again, conflicts := Merge(next, merged, sheet)
+	if !reflect.DeepEqual(again, merged) || len(conflicts) != 2 {
+		t.Errorf("second Merge() = %v, %v, want the first merge and its conflicts", again, conflicts)
+	}
+
+	resolved := component(
+		buildControl("AC-2", "partial", narrative("a", "new")),
+		buildControl("AC-3", "complete", narrative("a", "x")),
+	)
+	if _, conflicts := Merge(next, resolved, sheet); len(conflicts) != 0 {
+		t.Errorf("Merge() of the resolved component conflicts = %v, want none", conflicts)
+	}
+	if next := NextBase(next, sheet, nil); !reflect.DeepEqual(next, sheet) {
+		t.Errorf("NextBase() without conflicts = %v, want the sheet", next)
+	}
+}
+
+func TestNextBaseOwners(t *testing.T) {
+	got := NextBaseOwners(
+		Owners{"AC-2": "alice", "AC-3": "alice"},
+		Owners{"AC-2": "bob", "AC-3": "bob"},
+		[]Conflict{{ControlKey: "AC-2", Field: FieldStatus}},
+	)
+	if want := (Owners{"AC-2": "alice", "AC-3": "bob"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("NextBaseOwners() = %v, want %v", got, want)
+	}
+}
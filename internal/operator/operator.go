@@ -0,0 +1,328 @@
+// Package operator reads the results of the OpenShift Compliance Operator,
+// as exported with `oc get compliancecheckresults,compliancescans -o yaml`,
+// and maps them to the NIST 800-53 controls of the checks.
+package operator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+	"gopkg.in/yaml.v2"
+
+	"github.com/carlosmmatos/automate-compliance/internal/assessment"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+	"github.com/carlosmmatos/automate-compliance/internal/xccdf"
+)
+
+// Annotations and labels set by the Compliance Operator.
+const (
+	AnnotationControls = "compliance.openshift.io/controls"
+	AnnotationRule     = "compliance.openshift.io/rule"
+	LabelScanName      = "compliance.openshift.io/scan-name"
+	// controlAnnotationPrefix is followed by the standard, e.g.
+	// control.compliance.openshift.io/NIST-800-53
+	controlAnnotationPrefix = "control.compliance.openshift.io/"
+	nistStandard            = "NIST-800-53"
+)
+
+// Check is a ComplianceCheckResult.
+type Check struct {
+	Name   string
+	Rule   string
+	Scan   string
+	Title  string
+	Status string
+	// Controls are the keys of the NIST 800-53 controls the check covers
+	Controls []string
+}
+
+// Scan is a ComplianceScan.
+type Scan struct {
+	Name         string
+	Phase        string
+	Result       string
+	EndTimestamp string
+}
+
+// Results holds the checks and scans found in the exported objects.
+type Results struct {
+	Checks []Check
+	Scans  map[string]Scan
+	// Warnings are the controls that couldn't be mapped to a control key
+	Warnings []error
+}
+
+type object struct {
+	Kind     string `json:"kind" yaml:"kind"`
+	Metadata struct {
+		Name        string            `json:"name" yaml:"name"`
+		Labels      map[string]string `json:"labels" yaml:"labels"`
+		Annotations map[string]string `json:"annotations" yaml:"annotations"`
+	} `json:"metadata" yaml:"metadata"`
+	ID          string `json:"id" yaml:"id"`
+	Description string `json:"description" yaml:"description"`
+	// Status is the result of a check, or the status object of a scan
+	Status interface{} `json:"status" yaml:"status"`
+	Items  []object    `json:"items" yaml:"items"`
+}
+
+// Parse reads ComplianceCheckResult and ComplianceScan objects in YAML or
+// JSON. A file may hold several YAML documents, or lists of objects as
+// written by `oc get -o yaml`. Objects of other kinds are ignored.
+func Parse(r io.Reader) (*Results, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []object
+	if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		objects, err = decodeJSON(trimmed)
+	} else {
+		objects, err = decodeYAML(b)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Results{Scans: make(map[string]Scan)}
+	for _, o := range objects {
+		res.add(o)
+	}
+	return res, nil
+}
+
+func decodeJSON(b []byte) ([]object, error) {
+	if b[0] == '[' {
+		var objects []object
+		err := json.Unmarshal(b, &objects)
+		return objects, err
+	}
+	var o object
+	err := json.Unmarshal(b, &o)
+	return []object{o}, err
+}
+
+func decodeYAML(b []byte) ([]object, error) {
+	var objects []object
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	for {
+		var o object
+		err := dec.Decode(&o)
+		if err == io.EOF {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, o)
+	}
+}
+
+func (res *Results) add(o object) {
+	switch o.Kind {
+	case "List", "ComplianceCheckResultList", "ComplianceScanList":
+		for _, item := range o.Items {
+			res.add(item)
+		}
+	case "ComplianceCheckResult":
+		res.addCheck(o)
+	case "ComplianceScan":
+		res.Scans[o.Metadata.Name] = Scan{
+			Name:         o.Metadata.Name,
+			Phase:        field(o.Status, "phase"),
+			Result:       field(o.Status, "result"),
+			EndTimestamp: field(o.Status, "endTimestamp"),
+		}
+	}
+}
+
+func (res *Results) addCheck(o object) {
+	c := Check{
+		Name:  o.Metadata.Name,
+		Rule:  o.Metadata.Annotations[AnnotationRule],
+		Scan:  o.Metadata.Labels[LabelScanName],
+		Title: firstLine(o.Description),
+	}
+	if status, ok := o.Status.(string); ok {
+		c.Status = status
+	}
+	if c.Title == "" {
+		c.Title = c.Name
+	}
+
+	if c.Rule == "" {
+		c.Rule = o.ID
+	}
+
+	refs := nistReferences(o.Metadata.Annotations[AnnotationControls])
+	refs = append(refs, splitReferences(o.Metadata.Annotations[controlAnnotationPrefix+nistStandard])...)
+	seen := make(map[string]bool)
+	for _, ref := range refs {
+		keys, errs := xccdf.ControlKeys(ref)
+		for _, err := range errs {
+			res.Warnings = append(res.Warnings, fmt.Errorf("check %s: %v", c.Name, err))
+		}
+		for _, k := range keys {
+			if !seen[k] {
+				seen[k] = true
+				c.Controls = append(c.Controls, k)
+			}
+		}
+	}
+	res.Checks = append(res.Checks, c)
+}
+
+// nistReferences returns the NIST 800-53 references of a controls
+// annotation, e.g. "NIST-800-53:AC-2(1),AU-9;CIS-OCP:1.2.22" references
+// AC-2(1) and AU-9. References without standard are taken as NIST ones.
+func nistReferences(annotation string) []string {
+	var refs []string
+	for _, group := range strings.Split(annotation, ";") {
+		group = strings.TrimSpace(group)
+		if group == "" {
+			continue
+		}
+		if i := strings.Index(group, ":"); i >= 0 {
+			if group[:i] != nistStandard {
+				continue
+			}
+			group = group[i+1:]
+		}
+		refs = append(refs, splitReferences(group)...)
+	}
+	return refs
+}
+
+func splitReferences(value string) []string {
+	var refs []string
+	for _, ref := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ';' }) {
+		if ref = strings.TrimSpace(ref); ref != "" {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// field returns a string field of a status object decoded from YAML or JSON.
+func field(status interface{}, name string) string {
+	var v interface{}
+	switch m := status.(type) {
+	case map[interface{}]interface{}:
+		v = m[name]
+	case map[string]interface{}:
+		v = m[name]
+	}
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+func firstLine(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, "\n"); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(s)
+}
+
+// Load reads the objects exported to a file.
+func Load(path string) (*Results, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	res, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return res, nil
+}
+
+// Status maps the status of a check to the implementation status it implies
+// for the controls of the check. Checks that don't tell anything, such as
+// MANUAL or INFO, return an empty status.
+func Status(status string) string {
+	switch status {
+	case "PASS":
+		return parser.StatusComplete
+	case "FAIL", "ERROR":
+		return parser.StatusNone
+	// the check passes on some nodes and fails on others
+	case "INCONSISTENT":
+		return parser.StatusPartial
+	case "NOT-APPLICABLE":
+		return parser.StatusNotApplicable
+	default:
+		return ""
+	}
+}
+
+// ControlResults returns a result for every control of a check that has a
+// status, with the check as verification. Checks of the same rule run on
+// several node roles are combined, so a control is only complete when the
+// rule passes everywhere.
+func (res *Results) ControlResults(path string) ([]assessment.Result, []error) {
+	errs := append([]error(nil), res.Warnings...)
+	for _, s := range sortedScans(res.Scans) {
+		if s.Phase != "" && s.Phase != "DONE" {
+			errs = append(errs, fmt.Errorf("scan %s is %s, its results may be incomplete", s.Name, s.Phase))
+		} else if s.Result == "ERROR" {
+			errs = append(errs, fmt.Errorf("scan %s ended with an error, its results may be incomplete", s.Name))
+		}
+	}
+
+	var results []assessment.Result
+	mapped := false
+	for _, c := range res.Checks {
+		if len(c.Controls) > 0 {
+			mapped = true
+		}
+		status := Status(c.Status)
+		if status == "" {
+			continue
+		}
+		name := fmt.Sprintf("%s (%s)", c.Title, c.Status)
+		if s, ok := res.Scans[c.Scan]; ok && s.EndTimestamp != "" {
+			name = fmt.Sprintf("%s (%s in scan %s at %s)", c.Title, c.Status, s.Name, s.EndTimestamp)
+		}
+		for _, key := range c.Controls {
+			results = append(results, assessment.Result{
+				ControlKey: key,
+				Status:     status,
+				Verification: common.VerificationReference{
+					Key: "compliance-operator-" + c.Name,
+					GeneralReference: common.GeneralReference{
+						Name: name,
+						Path: path,
+						Type: "ComplianceCheckResult",
+					},
+				},
+			})
+		}
+	}
+	if len(res.Checks) > 0 && !mapped {
+		errs = append(errs, fmt.Errorf("%s: no check has a %s annotation, the controls of the checks are unknown", path, AnnotationControls))
+	}
+	return results, errs
+}
+
+func sortedScans(scans map[string]Scan) []Scan {
+	var sorted []Scan
+	for _, s := range scans {
+		sorted = append(sorted, s)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
@@ -0,0 +1,132 @@
+package operator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+
+	"github.com/carlosmmatos/automate-compliance/internal/assessment"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+const list = `apiVersion: v1
+kind: List
+items:
+- apiVersion: compliance.openshift.io/v1alpha1
+  kind: ComplianceCheckResult
+  metadata:
+    name: ocp4-moderate-api-server-audit-log-maxbackup
+    labels:
+      compliance.openshift.io/scan-name: ocp4-moderate
+    annotations:
+      compliance.openshift.io/rule: api-server-audit-log-maxbackup
+      compliance.openshift.io/controls: NIST-800-53:AU-9,AU-11;CIS-OCP:1.2.24
+  id: xccdf_org.ssgproject.content_rule_api_server_audit_log_maxbackup
+  description: |-
+    Configure the Maximum Retained Number of Audit Logs
+    To configure how many rotations of audit logs are retained...
+  status: PASS
+- apiVersion: compliance.openshift.io/v1alpha1
+  kind: ComplianceCheckResult
+  metadata:
+    name: ocp4-moderate-node-master-kubelet-enable-protect-kernel-defaults
+    labels:
+      compliance.openshift.io/scan-name: ocp4-moderate-node-master
+    annotations:
+      control.compliance.openshift.io/NIST-800-53: CM-6;CM-6(1);Bogus
+  status: INCONSISTENT
+- apiVersion: compliance.openshift.io/v1alpha1
+  kind: ComplianceCheckResult
+  metadata:
+    name: ocp4-moderate-manual-check
+    annotations:
+      compliance.openshift.io/controls: NIST-800-53:AC-2
+  status: MANUAL
+- apiVersion: compliance.openshift.io/v1alpha1
+  kind: ComplianceScan
+  metadata:
+    name: ocp4-moderate
+  status:
+    phase: DONE
+    result: NON-COMPLIANT
+    endTimestamp: "2021-09-01T10:00:00Z"
+---
+apiVersion: compliance.openshift.io/v1alpha1
+kind: ComplianceScan
+metadata:
+  name: ocp4-moderate-node-master
+status:
+  phase: RUNNING
+`
+
+const single = `{
+    "apiVersion": "compliance.openshift.io/v1alpha1",
+    "kind": "ComplianceCheckResult",
+    "metadata": {
+        "name": "ocp4-moderate-etcd-unique-ca",
+        "annotations": {"compliance.openshift.io/controls": "NIST-800-53:SC-8"}
+    },
+    "status": "FAIL"
+}`
+
+func ref(key, name string) common.VerificationReference {
+	return common.VerificationReference{Key: "compliance-operator-" + key, GeneralReference: common.GeneralReference{Name: name, Path: "results.yaml", Type: "ComplianceCheckResult"}}
+}
+
+func TestControlResults(t *testing.T) {
+	maxbackup := ref("ocp4-moderate-api-server-audit-log-maxbackup", "Configure the Maximum Retained Number of Audit Logs (PASS in scan ocp4-moderate at 2021-09-01T10:00:00Z)")
+	kernel := ref("ocp4-moderate-node-master-kubelet-enable-protect-kernel-defaults", "ocp4-moderate-node-master-kubelet-enable-protect-kernel-defaults (INCONSISTENT)")
+
+	tests := []struct {
+		name         string
+		doc          string
+		want         []assessment.Result
+		wantWarnings int
+	}{
+		{
+			"YAML lists and documents",
+			list,
+			[]assessment.Result{
+				{ControlKey: "AU-9", Status: parser.StatusComplete, Verification: maxbackup},
+				{ControlKey: "AU-11", Status: parser.StatusComplete, Verification: maxbackup},
+				{ControlKey: "CM-6", Status: parser.StatusPartial, Verification: kernel},
+				{ControlKey: "CM-6 (1)", Status: parser.StatusPartial, Verification: kernel},
+			},
+			// the unknown reference and the running scan
+			2,
+		},
+		{
+			"JSON object",
+			single,
+			[]assessment.Result{
+				{ControlKey: "SC-8", Status: parser.StatusNone, Verification: ref("ocp4-moderate-etcd-unique-ca", "ocp4-moderate-etcd-unique-ca (FAIL)")},
+			},
+			0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := Parse(strings.NewReader(tt.doc))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got, warnings := res.ControlResults("results.yaml")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Results.ControlResults() = %v, want %v", got, tt.want)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("Results.ControlResults() warnings = %v, want %d", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestNistReferences(t *testing.T) {
+	got := nistReferences("NIST-800-53:AC-2(1), AU-9;CIS-OCP:1.2.22;;SC-8")
+	want := []string{"AC-2(1)", "AU-9", "SC-8"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("nistReferences() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,141 @@
+package oscal
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/opencontrol"
+)
+
+// Names of the properties set on implemented requirements.
+const (
+	PropImplementationStatus = "implementation-status"
+	PropControlOrigination   = "control-origination"
+)
+
+// ComponentDefinition is an OSCAL component-definition document.
+type ComponentDefinition struct {
+	UUID       string             `json:"uuid"`
+	Metadata   Metadata           `json:"metadata"`
+	Components []DefinedComponent `json:"components"`
+}
+
+// DefinedComponent is a component of a ComponentDefinition.
+type DefinedComponent struct {
+	UUID                   string                  `json:"uuid"`
+	Type                   string                  `json:"type"`
+	Title                  string                  `json:"title"`
+	Description            string                  `json:"description"`
+	ControlImplementations []ControlImplementation `json:"control-implementations,omitempty"`
+}
+
+// ControlImplementation groups the requirements a component implements from
+// a catalog.
+type ControlImplementation struct {
+	UUID                    string                   `json:"uuid"`
+	Source                  string                   `json:"source"`
+	Description             string                   `json:"description"`
+	ImplementedRequirements []ImplementedRequirement `json:"implemented-requirements"`
+}
+
+// ImplementedRequirement describes how a component implements a control.
+type ImplementedRequirement struct {
+	UUID        string      `json:"uuid"`
+	ControlID   string      `json:"control-id"`
+	Description string      `json:"description"`
+	Props       []Property  `json:"props,omitempty"`
+	Statements  []Statement `json:"statements,omitempty"`
+}
+
+// Statement describes how a component implements a part of a control.
+type Statement struct {
+	StatementID string `json:"statement-id"`
+	UUID        string `json:"uuid"`
+	Description string `json:"description"`
+}
+
+// NewComponentDefinition builds a component-definition out of OpenControl
+// components. The UUIDs are derived from the title, component names and
+// control keys, so they are stable across runs.
+func NewComponentDefinition(title, catalog string, components []*v3c.Component, modified time.Time) ComponentDefinition {
+	def := ComponentDefinition{
+		UUID: UUID("component-definition", title),
+		Metadata: Metadata{
+			Title:        title,
+			LastModified: modified.UTC().Truncate(time.Second),
+			Version:      modified.UTC().Format("2006-01-02"),
+			OSCALVersion: Version,
+		},
+	}
+	for _, c := range components {
+		def.Components = append(def.Components, definedComponent(c, catalog))
+	}
+	return def
+}
+
+func definedComponent(c *v3c.Component, catalog string) DefinedComponent {
+	dc := DefinedComponent{
+		UUID:        UUID("component", c.Name),
+		Type:        "software",
+		Title:       c.Name,
+		Description: c.Name,
+	}
+
+	ci := ControlImplementation{
+		UUID:        UUID("control-implementation", c.Name, catalog),
+		Source:      catalog,
+		Description: "Controls implemented by " + c.Name,
+	}
+	satisfies := append([]v3c.Satisfies(nil), c.Satisfies...)
+	opencontrol.SortSatisfies(satisfies)
+	for _, s := range satisfies {
+		ci.ImplementedRequirements = append(ci.ImplementedRequirements, implementedRequirement(c.Name, s))
+	}
+	if len(ci.ImplementedRequirements) > 0 {
+		dc.ControlImplementations = []ControlImplementation{ci}
+	}
+	return dc
+}
+
+func implementedRequirement(component string, s v3c.Satisfies) ImplementedRequirement {
+	ir := ImplementedRequirement{
+		UUID:      UUID("implemented-requirement", component, s.ControlKey),
+		ControlID: ControlID(s.ControlKey),
+	}
+	if status := ImplementationStatus(s.ImplementationStatus); status != "" {
+		ir.Props = append(ir.Props, Property{Name: PropImplementationStatus, Value: status})
+	}
+	if s.ControlOrigin != "" {
+		ir.Props = append(ir.Props, Property{Name: PropControlOrigination, Value: ControlOrigination(s.ControlOrigin)})
+	}
+
+	narratives := append([]v3c.NarrativeSection(nil), s.Narrative...)
+	opencontrol.SortNarratives(narratives)
+	var descriptions []string
+	for _, n := range narratives {
+		text := strings.TrimSpace(n.Text)
+		if n.Key == "" {
+			descriptions = append(descriptions, text)
+			continue
+		}
+		ir.Statements = append(ir.Statements, Statement{
+			StatementID: StatementID(s.ControlKey, n.Key),
+			UUID:        UUID("statement", component, s.ControlKey, n.Key),
+			Description: text,
+		})
+	}
+	ir.Description = strings.Join(descriptions, "\n\n")
+	if ir.Description == "" {
+		// the description is required, even when every narrative has a key
+		ir.Description = "See the statements of " + s.ControlKey + "."
+	}
+	return ir
+}
+
+// WriteJSON writes the component-definition as OSCAL JSON.
+func (d ComponentDefinition) WriteJSON(w io.Writer) error {
+	return writeJSON(w, "component-definition", d)
+}
@@ -0,0 +1,172 @@
+// Package oscal converts the parsed controls into OSCAL documents.
+package oscal
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// Version is the OSCAL version of the documents we generate.
+const Version = "1.0.4"
+
+// DefaultCatalog is the catalog the control ids refer to.
+const DefaultCatalog = "https://raw.githubusercontent.com/usnistgov/oscal-content/main/nist.gov/SP800-53/rev5/json/NIST_SP-800-53_rev5_catalog.json"
+
+// Metadata is the metadata assembly shared by every OSCAL document.
+type Metadata struct {
+	Title        string     `json:"title"`
+	LastModified time.Time  `json:"last-modified"`
+	Version      string     `json:"version"`
+	OSCALVersion string     `json:"oscal-version"`
+	Props        []Property `json:"props,omitempty"`
+	Roles        []Role     `json:"roles,omitempty"`
+	Parties      []Party    `json:"parties,omitempty"`
+	// ResponsibleParties lists the parties assigned to each role
+	ResponsibleParties []ResponsibleParty `json:"responsible-parties,omitempty"`
+}
+
+// Property is a name/value pair attached to most OSCAL objects.
+type Property struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	NS    string `json:"ns,omitempty"`
+}
+
+// Role is a role parties can be responsible for.
+type Role struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// Party is a person or organization.
+type Party struct {
+	UUID   string   `json:"uuid"`
+	Type   string   `json:"type"`
+	Name   string   `json:"name,omitempty"`
+	Emails []string `json:"email-addresses,omitempty"`
+}
+
+// ResponsibleParty assigns parties to a role.
+type ResponsibleParty struct {
+	RoleID     string   `json:"role-id"`
+	PartyUUIDs []string `json:"party-uuids"`
+}
+
+// ResponsibleRole assigns a role, and optionally parties, to an object.
+type ResponsibleRole struct {
+	RoleID     string   `json:"role-id"`
+	PartyUUIDs []string `json:"party-uuids,omitempty"`
+}
+
+// namespace is the UUID v5 namespace of every UUID we generate.
+var namespace = [16]byte{
+	0x6b, 0x1f, 0x2c, 0x5e, 0x3a, 0x47, 0x4f, 0x0e,
+	0x9d, 0x61, 0x1c, 0x5b, 0x2e, 0x87, 0x3c, 0x90,
+}
+
+// UUID returns a version 5 UUID derived from the parts, so the same object
+// gets the same UUID on every run and the documents diff cleanly.
+func UUID(parts ...string) string {
+	h := sha1.New()
+	h.Write(namespace[:])
+	h.Write([]byte(strings.Join(parts, "\x00")))
+	u := h.Sum(nil)[:16]
+	u[6] = (u[6] & 0x0f) | 0x50
+	u[8] = (u[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:16])
+}
+
+var controlKeyRe = regexp.MustCompile(`^([A-Z]+)-([0-9]+)(?: \(([0-9]+)\))?$`)
+
+// ControlID returns the OSCAL control id of a control key, e.g. "ac-2.1" for
+// "AC-2 (1)". Keys that aren't NIST controls are lower-cased.
+func ControlID(controlKey string) string {
+	m := controlKeyRe.FindStringSubmatch(controlKey)
+	if m == nil {
+		return strings.ToLower(controlKey)
+	}
+	id := strings.ToLower(m[1]) + "-" + m[2]
+	if m[3] != "" {
+		id += "." + m[3]
+	}
+	return id
+}
+
+// StatementID returns the OSCAL id of the statement part a narrative key
+// addresses, e.g. "ac-2_smt.b.1" for key "b.1" of "AC-2".
+func StatementID(controlKey, narrativeKey string) string {
+	id := ControlID(controlKey) + "_smt"
+	if narrativeKey != "" {
+		id += "." + narrativeKey
+	}
+	return id
+}
+
+// Values of the implementation-status property.
+const (
+	StatusImplemented   = "implemented"
+	StatusPartial       = "partial"
+	StatusPlanned       = "planned"
+	StatusNotApplicable = "not-applicable"
+)
+
+// ImplementationStatus maps an OpenControl implementation status to its OSCAL
+// counterpart. OSCAL has no value for a control that isn't implemented at
+// all, so "none" becomes planned.
+func ImplementationStatus(status string) string {
+	switch status {
+	case parser.StatusComplete:
+		return StatusImplemented
+	case parser.StatusPartial:
+		return StatusPartial
+	case parser.StatusPlanned, parser.StatusNone:
+		return StatusPlanned
+	case parser.StatusNotApplicable:
+		return StatusNotApplicable
+	default:
+		return ""
+	}
+}
+
+// ControlOrigination maps an OpenControl control origin to the values of the
+// FedRAMP control-origination property. Unknown origins are kept as is.
+func ControlOrigination(origin string) string {
+	switch origin {
+	case "service_provider_corporate":
+		return "sp-corporate"
+	case "service_provider_system_specific", "service_provider_system":
+		return "sp-system"
+	case "service_provider_hybrid", "shared":
+		return "shared"
+	case "configured_by_customer", "customer_configured":
+		return "customer-configured"
+	case "provided_by_customer", "customer_provided":
+		return "customer-provided"
+	case "inherited":
+		return "inherited"
+	default:
+		return strings.Replace(origin, "_", "-", -1)
+	}
+}
+
+// writeJSON validates an OSCAL document and writes it wrapped in its root
+// property.
+func writeJSON(w io.Writer, root string, doc interface{}) error {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	if err := Validate(root, b); err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(map[string]interface{}{root: doc})
+}
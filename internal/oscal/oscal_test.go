@@ -0,0 +1,431 @@
+package oscal
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/assessment"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+var uuidRe = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestUUID(t *testing.T) {
+	a := UUID("component", "RHACM")
+	if !uuidRe.MatchString(a) {
+		t.Errorf("%q is not a version 5 UUID", a)
+	}
+	if b := UUID("component", "RHACM"); a != b {
+		t.Errorf("UUID isn't stable: %q != %q", a, b)
+	}
+	if b := UUID("component", "ACS"); a == b {
+		t.Errorf("different names got the same UUID %q", a)
+	}
+	if b := UUID("componentR", "HACM"); a == b {
+		t.Errorf("parts aren't separated: %q", a)
+	}
+}
+
+func TestControlID(t *testing.T) {
+	tests := []struct {
+		key       string
+		narrative string
+		control   string
+		statement string
+	}{
+		{"AC-2", "", "ac-2", "ac-2_smt"},
+		{"AC-2", "a", "ac-2", "ac-2_smt.a"},
+		{"AC-3 (3)", "b.2", "ac-3.3", "ac-3.3_smt.b.2"},
+		{"Custom", "", "custom", "custom_smt"},
+	}
+	for _, tt := range tests {
+		if got := ControlID(tt.key); got != tt.control {
+			t.Errorf("ControlID(%q) = %q, want %q", tt.key, got, tt.control)
+		}
+		if got := StatementID(tt.key, tt.narrative); got != tt.statement {
+			t.Errorf("StatementID(%q, %q) = %q, want %q", tt.key, tt.narrative, got, tt.statement)
+		}
+	}
+}
+
+func TestImplementationStatus(t *testing.T) {
+	tests := []struct {
+		status string
+		want   string
+	}{
+		{parser.StatusComplete, StatusImplemented},
+		{parser.StatusPartial, StatusPartial},
+		{parser.StatusPlanned, StatusPlanned},
+		{parser.StatusNone, StatusPlanned},
+		{parser.StatusNotApplicable, StatusNotApplicable},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := ImplementationStatus(tt.status); got != tt.want {
+			t.Errorf("ImplementationStatus(%q) = %q, want %q", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestNewComponentDefinition(t *testing.T) {
+	comp := &v3c.Component{
+		Name: "RHACM",
+		Satisfies: []v3c.Satisfies{
+			{
+				ControlKey: "AC-3 (3)",
+				Narrative: []v3c.NarrativeSection{
+					{Key: "b.1", Text: "B1"},
+					{Key: "a", Text: "A"},
+				},
+			},
+			{
+				ControlKey:           "AC-2",
+				ImplementationStatus: parser.StatusComplete,
+				ControlOrigin:        "service_provider_corporate",
+				Narrative:            []v3c.NarrativeSection{{Text: "Accounts are reviewed"}},
+			},
+		},
+	}
+	modified := time.Date(2021, 7, 1, 10, 30, 0, 0, time.UTC)
+	def := NewComponentDefinition("Test", DefaultCatalog, []*v3c.Component{comp}, modified)
+
+	want := []ImplementedRequirement{
+		{
+			UUID:        UUID("implemented-requirement", "RHACM", "AC-2"),
+			ControlID:   "ac-2",
+			Description: "Accounts are reviewed",
+			Props: []Property{
+				{Name: PropImplementationStatus, Value: StatusImplemented},
+				{Name: PropControlOrigination, Value: "sp-corporate"},
+			},
+		},
+		{
+			UUID:        UUID("implemented-requirement", "RHACM", "AC-3 (3)"),
+			ControlID:   "ac-3.3",
+			Description: "See the statements of AC-3 (3).",
+			Statements: []Statement{
+				{StatementID: "ac-3.3_smt.a", UUID: UUID("statement", "RHACM", "AC-3 (3)", "a"), Description: "A"},
+				{StatementID: "ac-3.3_smt.b.1", UUID: UUID("statement", "RHACM", "AC-3 (3)", "b.1"), Description: "B1"},
+			},
+		},
+	}
+	if len(def.Components) != 1 || len(def.Components[0].ControlImplementations) != 1 {
+		t.Fatalf("unexpected components: %+v", def.Components)
+	}
+	got := def.Components[0].ControlImplementations[0].ImplementedRequirements
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	var buf bytes.Buffer
+	if err := def.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	meta := doc["component-definition"]["metadata"].(map[string]interface{})
+	if meta["last-modified"] != "2021-07-01T10:30:00Z" || meta["oscal-version"] != Version {
+		t.Errorf("unexpected metadata %v", meta)
+	}
+}
+
+func writeConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "system.yaml")
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadSystemConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"minimal", "system-name: Example\ncategorization: Moderate\nauthorization-boundary: Everything\n", false},
+		{"no name", "categorization: low\nauthorization-boundary: Everything\n", true},
+		{"bad categorization", "system-name: Example\ncategorization: extreme\nauthorization-boundary: Everything\n", true},
+		{"no boundary", "system-name: Example\ncategorization: low\n", true},
+		{"unknown role", "system-name: Example\ncategorization: low\nauthorization-boundary: Everything\nparties:\n  - name: Jane\n    roles: [owner]\n", true},
+		{"unknown field", "system-name: Example\ncategorization: low\nauthorization-boundary: Everything\ncolor: blue\n", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadSystemConfig(writeConfig(t, tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	cfg, err := LoadSystemConfig(writeConfig(t, tests[0].content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := SystemConfig{
+		Title:                 "Example System Security Plan",
+		SystemName:            "Example",
+		SystemID:              "Example",
+		Description:           "Example",
+		Categorization:        "moderate",
+		Confidentiality:       "moderate",
+		Integrity:             "moderate",
+		Availability:          "moderate",
+		Status:                "operational",
+		AuthorizationBoundary: "Everything",
+		Profile:               BaselineProfile("moderate"),
+		InformationTypes: []InformationType{{
+			Title:           "Example",
+			Description:     "Information processed by Example",
+			Confidentiality: "moderate",
+			Integrity:       "moderate",
+			Availability:    "moderate",
+		}},
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("got %+v, want %+v", cfg, want)
+	}
+}
+
+func TestNewSSP(t *testing.T) {
+	cfg, err := LoadSystemConfig(writeConfig(t, `
+system-name: Example
+categorization: low
+authorization-boundary: Everything
+roles:
+  - id: system-owner
+    title: System Owner
+parties:
+  - name: Jane Doe
+    roles: [system-owner]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	acm := &v3c.Component{Name: "ACM", Satisfies: []v3c.Satisfies{
+		{ControlKey: "AC-2", ImplementationStatus: parser.StatusPlanned, Narrative: []v3c.NarrativeSection{{Key: "b", Text: "ACM b"}, {Key: "a", Text: "ACM a"}}},
+		{ControlKey: "AC-3", Narrative: []v3c.NarrativeSection{{Text: "ACM enforces"}}},
+	}}
+	acs := &v3c.Component{Name: "ACS", Satisfies: []v3c.Satisfies{
+		{ControlKey: "AC-2", Narrative: []v3c.NarrativeSection{{Key: "a", Text: "ACS a"}}},
+	}}
+	ssp := NewSSP(cfg, []*v3c.Component{acm, acs}, time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC))
+
+	want := []SSPImplementedRequirement{
+		{
+			UUID:      UUID("ssp-implemented-requirement", "Example", "AC-2"),
+			ControlID: "ac-2",
+			Statements: []SSPStatement{
+				{
+					StatementID: "ac-2_smt.a",
+					UUID:        UUID("ssp-statement", "Example", "AC-2", "a"),
+					ByComponents: []ByComponent{
+						{ComponentUUID: UUID("component", "ACM"), UUID: UUID("by-component", "Example", "ACM", "AC-2", "a"), Description: "ACM a", ImplementationStatus: &State{StatusPlanned}},
+						{ComponentUUID: UUID("component", "ACS"), UUID: UUID("by-component", "Example", "ACS", "AC-2", "a"), Description: "ACS a"},
+					},
+				},
+				{
+					StatementID: "ac-2_smt.b",
+					UUID:        UUID("ssp-statement", "Example", "AC-2", "b"),
+					ByComponents: []ByComponent{
+						{ComponentUUID: UUID("component", "ACM"), UUID: UUID("by-component", "Example", "ACM", "AC-2", "b"), Description: "ACM b", ImplementationStatus: &State{StatusPlanned}},
+					},
+				},
+			},
+		},
+		{
+			UUID:      UUID("ssp-implemented-requirement", "Example", "AC-3"),
+			ControlID: "ac-3",
+			ByComponents: []ByComponent{
+				{ComponentUUID: UUID("component", "ACM"), UUID: UUID("by-component", "Example", "ACM", "AC-3", ""), Description: "ACM enforces"},
+			},
+		},
+	}
+	if got := ssp.ControlImplementation.ImplementedRequirements; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if got := len(ssp.SystemImplementation.Components); got != 3 {
+		t.Errorf("got %d components, want this-system and the 2 products", got)
+	}
+	wantParties := []ResponsibleParty{{RoleID: "system-owner", PartyUUIDs: []string{UUID("party", "Jane Doe")}}}
+	if !reflect.DeepEqual(ssp.Metadata.ResponsibleParties, wantParties) {
+		t.Errorf("got responsible parties %+v, want %+v", ssp.Metadata.ResponsibleParties, wantParties)
+	}
+
+	// WriteJSON validates the document against the schema
+	if err := ssp.WriteJSON(ioutil.Discard); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		root    string
+		doc     string
+		wantErr bool
+	}{
+		{"valid", "component-definition", `{"uuid": "1cd7331e-1254-5068-975f-e9518b759130", "metadata": {"title": "T", "last-modified": "2021-07-01T00:00:00Z", "version": "1", "oscal-version": "1.0.4"}}`, false},
+		{"bad uuid", "component-definition", `{"uuid": "nope", "metadata": {"title": "T", "last-modified": "2021-07-01T00:00:00Z", "version": "1", "oscal-version": "1.0.4"}}`, true},
+		{"missing metadata", "component-definition", `{"uuid": "1cd7331e-1254-5068-975f-e9518b759130"}`, true},
+		{"unknown root", "catalogue", `{}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.root, []byte(tt.doc))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewPOAM(t *testing.T) {
+	product := Product{
+		Name: "ACM",
+		Data: parser.Data{
+			"AC-Access_Control": {
+				"AC-2": {ControlKey: "AC-2", ImplementationStatus: parser.StatusPartial, Narrative: []v3c.NarrativeSection{{Key: "b", Text: "Groups"}, {Key: "a", Text: parser.PlaceholderEnhancement}}},
+				"AC-3": {ControlKey: "AC-3", ImplementationStatus: parser.StatusComplete},
+				"AC-4": {ControlKey: "AC-4", ImplementationStatus: parser.StatusNone},
+			},
+		},
+		Details: map[string]parser.Details{
+			"AC-2": {Owner: "jdoe", Milestone: "2021-09-30"},
+		},
+	}
+	poam := NewPOAM("Plan", "EC", []Product{product}, time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC))
+
+	deadline := time.Date(2021, 9, 30, 0, 0, 0, 0, time.UTC)
+	jdoe := UUID("party", "jdoe")
+	want := []Risk{
+		{
+			UUID:        UUID("risk", "ACM", "AC-2"),
+			Title:       "AC-2 is partially implemented in ACM",
+			Description: "b. Groups",
+			Statement:   "The requirements of AC-2 are not fully met by ACM.",
+			Props:       []Property{{Name: PropControlID, Value: "ac-2"}, {Name: PropImplementationStatus, Value: StatusPartial}},
+			Status:      "open",
+			Deadline:    &deadline,
+			Remediations: []Response{{
+				UUID:        UUID("remediation", "ACM", "AC-2"),
+				Lifecycle:   "planned",
+				Title:       "Implement AC-2",
+				Description: "Complete the implementation of AC-2 in ACM.",
+				Tasks: []Task{{
+					UUID:             UUID("task", "ACM", "AC-2"),
+					Type:             "milestone",
+					Title:            "AC-2 implemented",
+					Timing:           &Timing{OnDate: OnDate{Date: deadline}},
+					ResponsibleRoles: []ResponsibleRole{{RoleID: RoleOwner, PartyUUIDs: []string{jdoe}}},
+				}},
+			}},
+		},
+		{
+			UUID:        UUID("risk", "ACM", "AC-4"),
+			Title:       "AC-4 is not implemented in ACM",
+			Description: "AC-4 is not implemented in ACM.",
+			Statement:   "The requirements of AC-4 are not fully met by ACM.",
+			Props:       []Property{{Name: PropControlID, Value: "ac-4"}, {Name: PropImplementationStatus, Value: StatusPlanned}},
+			Status:      "open",
+		},
+	}
+	if !reflect.DeepEqual(poam.Risks, want) {
+		t.Errorf("got risks %+v, want %+v", poam.Risks, want)
+	}
+
+	if len(poam.POAMItems) != 2 || poam.POAMItems[1].RelatedRisks[0].RiskUUID != want[1].UUID {
+		t.Errorf("unexpected items %+v", poam.POAMItems)
+	}
+	if len(poam.Metadata.Parties) != 1 || poam.Metadata.Parties[0].UUID != jdoe {
+		t.Errorf("unexpected parties %+v", poam.Metadata.Parties)
+	}
+	if err := poam.WriteJSON(ioutil.Discard); err != nil {
+		t.Error(err)
+	}
+
+	empty := NewPOAM("Plan", "", nil, time.Now())
+	if err := empty.WriteJSON(ioutil.Discard); err == nil {
+		t.Error("expected an error for a plan without items")
+	}
+}
+
+func TestControlKey(t *testing.T) {
+	tests := []struct {
+		id      string
+		want    string
+		wantErr bool
+	}{
+		{"ac-2", "AC-2", false},
+		{"ac-2_smt.a", "AC-2", false},
+		{"ac-2.1", "AC-2 (1)", false},
+		{"ac-2.1_obj.a-1", "AC-2 (1)", false},
+		{"zz", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, err := ControlKey(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ControlKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ControlKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadAssessmentResults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ar.json")
+	doc := `{"assessment-results": {"uuid": "u", "results": [{
+		"uuid": "r",
+		"observations": [{"uuid": "o1", "relevant-evidence": [{"href": "https://evidence.example/ac-3"}]}],
+		"findings": [
+			{"uuid": "f1", "title": "AC-2 a", "target": {"target-id": "ac-2_smt.a", "status": {"state": "satisfied"}}},
+			{"uuid": "f2", "title": "AC-3", "target": {"target-id": "ac-3_obj", "status": {"state": "not-satisfied"}}, "related-observations": [{"observation-uuid": "o1"}]},
+			{"uuid": "f3", "title": "AC-4", "target": {"target-id": "ac-4", "status": {"state": "not-satisfied"}, "implementation-status": {"state": "partial"}}},
+			{"uuid": "f4", "title": "Other", "target": {"target-id": "x", "status": {"state": "satisfied"}}}
+		]
+	}]}}`
+	if err := ioutil.WriteFile(path, []byte(doc), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ar, err := LoadAssessmentResults(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, errs := ar.ControlResults(path)
+	if len(errs) != 1 {
+		t.Errorf("got errors %v, want one for finding f4", errs)
+	}
+
+	ref := func(key, name, p, typ string) common.VerificationReference {
+		return common.VerificationReference{Key: key, GeneralReference: common.GeneralReference{Name: name, Path: p, Type: typ}}
+	}
+	want := []assessment.Result{
+		{ControlKey: "AC-2", Status: parser.StatusComplete, Verification: ref("oscal-f1", "AC-2 a (satisfied)", path, "OSCAL assessment results")},
+		{ControlKey: "AC-3", Status: parser.StatusNone, Verification: ref("oscal-f2", "AC-3 (not-satisfied)", "https://evidence.example/ac-3", "URL")},
+		{ControlKey: "AC-4", Status: parser.StatusPartial, Verification: ref("oscal-f3", "AC-4 (not-satisfied)", path, "OSCAL assessment results")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if _, err := LoadAssessmentResults(writeConfig(t, "{}")); err == nil {
+		t.Error("expected an error for a document that isn't assessment-results")
+	}
+}
@@ -0,0 +1,242 @@
+package oscal
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// Product is the parsed sheet of a product along with the details of its
+// controls.
+type Product struct {
+	Name    string
+	Data    parser.Data
+	Details map[string]parser.Details
+}
+
+// Names of the properties and roles set on POA&M entries.
+const (
+	PropControlID = "control-id"
+	RoleOwner     = "control-owner"
+)
+
+// POAM is an OSCAL plan-of-action-and-milestones document.
+type POAM struct {
+	UUID      string     `json:"uuid"`
+	Metadata  Metadata   `json:"metadata"`
+	SystemID  *SystemID  `json:"system-id,omitempty"`
+	Risks     []Risk     `json:"risks,omitempty"`
+	POAMItems []POAMItem `json:"poam-items"`
+}
+
+// Risk is the risk of a control not being fully implemented.
+type Risk struct {
+	UUID         string     `json:"uuid"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	Statement    string     `json:"statement"`
+	Props        []Property `json:"props,omitempty"`
+	Status       string     `json:"status"`
+	Deadline     *time.Time `json:"deadline,omitempty"`
+	Remediations []Response `json:"remediations,omitempty"`
+}
+
+// Response is the plan to remediate a risk.
+type Response struct {
+	UUID        string `json:"uuid"`
+	Lifecycle   string `json:"lifecycle"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Tasks       []Task `json:"tasks,omitempty"`
+}
+
+// Task is a milestone of a remediation.
+type Task struct {
+	UUID             string            `json:"uuid"`
+	Type             string            `json:"type"`
+	Title            string            `json:"title"`
+	Timing           *Timing           `json:"timing,omitempty"`
+	ResponsibleRoles []ResponsibleRole `json:"responsible-roles,omitempty"`
+}
+
+// Timing is when a task is due.
+type Timing struct {
+	OnDate OnDate `json:"on-date"`
+}
+
+// OnDate is the date a task is due.
+type OnDate struct {
+	Date time.Time `json:"date"`
+}
+
+// POAMItem is an entry of the plan.
+type POAMItem struct {
+	UUID         string        `json:"uuid"`
+	Title        string        `json:"title"`
+	Description  string        `json:"description"`
+	Props        []Property    `json:"props,omitempty"`
+	RelatedRisks []RelatedRisk `json:"related-risks,omitempty"`
+}
+
+// RelatedRisk references a risk of the plan.
+type RelatedRisk struct {
+	RiskUUID string `json:"risk-uuid"`
+}
+
+// Incomplete returns true if a control with the status needs a POA&M entry.
+func Incomplete(status string) bool {
+	switch status {
+	case parser.StatusPlanned, parser.StatusPartial, parser.StatusNone:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewPOAM builds a plan-of-action-and-milestones with an item for every
+// planned, partial or unimplemented control of the products. Each item is
+// linked to a risk that carries the control id, the milestone date and the
+// owner of the control.
+func NewPOAM(title, systemID string, products []Product, modified time.Time) POAM {
+	poam := POAM{
+		UUID: UUID("plan-of-action-and-milestones", title),
+		Metadata: Metadata{
+			Title:        title,
+			LastModified: modified.UTC().Truncate(time.Second),
+			Version:      modified.UTC().Format("2006-01-02"),
+			OSCALVersion: Version,
+		},
+	}
+	if systemID != "" {
+		poam.SystemID = &SystemID{ID: systemID}
+	}
+
+	owners := make(map[string]bool)
+	for _, p := range products {
+		for _, s := range incompleteControls(p.Data) {
+			details := p.Details[s.ControlKey]
+			risk := newRisk(p.Name, s, details)
+			poam.Risks = append(poam.Risks, risk)
+			poam.POAMItems = append(poam.POAMItems, POAMItem{
+				UUID:         UUID("poam-item", p.Name, s.ControlKey),
+				Title:        risk.Title,
+				Description:  risk.Description,
+				Props:        risk.Props,
+				RelatedRisks: []RelatedRisk{{RiskUUID: risk.UUID}},
+			})
+			if details.Owner != "" {
+				owners[details.Owner] = true
+			}
+		}
+	}
+
+	if len(owners) > 0 {
+		poam.Metadata.Roles = []Role{{ID: RoleOwner, Title: "Control owner"}}
+		var names []string
+		for o := range owners {
+			names = append(names, o)
+		}
+		sort.Strings(names)
+		for _, o := range names {
+			poam.Metadata.Parties = append(poam.Metadata.Parties, Party{UUID: UUID("party", o), Type: "person", Name: o})
+		}
+	}
+	return poam
+}
+
+func incompleteControls(data parser.Data) []v3c.Satisfies {
+	var ctrls []v3c.Satisfies
+	for _, family := range data {
+		for _, s := range family {
+			if Incomplete(s.ImplementationStatus) {
+				ctrls = append(ctrls, s)
+			}
+		}
+	}
+	sort.Slice(ctrls, func(i, j int) bool {
+		return sortorder.NaturalLess(ctrls[i].ControlKey, ctrls[j].ControlKey)
+	})
+	return ctrls
+}
+
+var statusTitles = map[string]string{
+	parser.StatusPlanned: "is planned",
+	parser.StatusPartial: "is partially implemented",
+	parser.StatusNone:    "is not implemented",
+}
+
+func newRisk(product string, s v3c.Satisfies, details parser.Details) Risk {
+	key := s.ControlKey
+	risk := Risk{
+		UUID:        UUID("risk", product, key),
+		Title:       fmt.Sprintf("%s %s in %s", key, statusTitles[s.ImplementationStatus], product),
+		Description: narrativeText(s.Narrative),
+		Statement:   fmt.Sprintf("The requirements of %s are not fully met by %s.", key, product),
+		Props: []Property{
+			{Name: PropControlID, Value: ControlID(key)},
+			{Name: PropImplementationStatus, Value: ImplementationStatus(s.ImplementationStatus)},
+		},
+		Status: "open",
+	}
+	if risk.Description == "" {
+		risk.Description = risk.Title + "."
+	}
+
+	task := Task{
+		UUID:  UUID("task", product, key),
+		Type:  "milestone",
+		Title: fmt.Sprintf("%s implemented", key),
+	}
+	if t, err := parser.ParseDate(details.Milestone); err == nil {
+		risk.Deadline = &t
+		task.Timing = &Timing{OnDate: OnDate{Date: t}}
+	}
+	if details.Owner != "" {
+		task.ResponsibleRoles = []ResponsibleRole{{RoleID: RoleOwner, PartyUUIDs: []string{UUID("party", details.Owner)}}}
+	}
+	if task.Timing != nil || task.ResponsibleRoles != nil {
+		risk.Remediations = []Response{{
+			UUID:        UUID("remediation", product, key),
+			Lifecycle:   "planned",
+			Title:       "Implement " + key,
+			Description: fmt.Sprintf("Complete the implementation of %s in %s.", key, product),
+			Tasks:       []Task{task},
+		}}
+	}
+	return risk
+}
+
+// narrativeText joins the narratives that aren't placeholders.
+func narrativeText(narratives []v3c.NarrativeSection) string {
+	narratives = append([]v3c.NarrativeSection(nil), narratives...)
+	sort.SliceStable(narratives, func(i, j int) bool {
+		return sortorder.NaturalLess(narratives[i].Key, narratives[j].Key)
+	})
+	var texts []string
+	for _, n := range narratives {
+		if parser.IsPlaceholder(n.Text) {
+			continue
+		}
+		text := strings.TrimSpace(n.Text)
+		if n.Key != "" {
+			text = n.Key + ". " + text
+		}
+		texts = append(texts, text)
+	}
+	return strings.Join(texts, "\n\n")
+}
+
+// WriteJSON writes the plan as OSCAL JSON.
+func (p POAM) WriteJSON(w io.Writer) error {
+	if len(p.POAMItems) == 0 {
+		return fmt.Errorf("no planned, partial or unimplemented controls, an OSCAL POA&M needs at least one item")
+	}
+	return writeJSON(w, "plan-of-action-and-milestones", p)
+}
@@ -0,0 +1,180 @@
+package oscal
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+
+	"github.com/carlosmmatos/automate-compliance/internal/assessment"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// AssessmentResults is the part of an OSCAL assessment-results document we
+// read.
+type AssessmentResults struct {
+	UUID     string   `json:"uuid"`
+	Metadata Metadata `json:"metadata"`
+	Results  []Result `json:"results"`
+}
+
+// Result is an assessment of the system.
+type Result struct {
+	UUID         string        `json:"uuid"`
+	Title        string        `json:"title"`
+	Start        time.Time     `json:"start"`
+	Observations []Observation `json:"observations"`
+	Findings     []Finding     `json:"findings"`
+}
+
+// Observation is something the assessor observed.
+type Observation struct {
+	UUID             string             `json:"uuid"`
+	Title            string             `json:"title"`
+	Description      string             `json:"description"`
+	Methods          []string           `json:"methods"`
+	Collected        time.Time          `json:"collected"`
+	RelevantEvidence []RelevantEvidence `json:"relevant-evidence"`
+}
+
+// RelevantEvidence links to the evidence of an observation.
+type RelevantEvidence struct {
+	Href        string `json:"href"`
+	Description string `json:"description"`
+}
+
+// Finding is the conclusion of the assessor about a control.
+type Finding struct {
+	UUID                string               `json:"uuid"`
+	Title               string               `json:"title"`
+	Description         string               `json:"description"`
+	Target              FindingTarget        `json:"target"`
+	RelatedObservations []RelatedObservation `json:"related-observations"`
+}
+
+// FindingTarget is the control, statement or objective a finding is about.
+type FindingTarget struct {
+	Type                 string `json:"type"`
+	TargetID             string `json:"target-id"`
+	Status               State  `json:"status"`
+	ImplementationStatus *State `json:"implementation-status"`
+}
+
+// RelatedObservation references an observation a finding is based on.
+type RelatedObservation struct {
+	ObservationUUID string `json:"observation-uuid"`
+}
+
+// LoadAssessmentResults reads an OSCAL assessment-results JSON file.
+func LoadAssessmentResults(path string) (*AssessmentResults, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc struct {
+		AssessmentResults *AssessmentResults `json:"assessment-results"`
+	}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if doc.AssessmentResults == nil {
+		return nil, fmt.Errorf("%s: not an OSCAL assessment-results document", path)
+	}
+	return doc.AssessmentResults, nil
+}
+
+// ControlKey returns the control key of an OSCAL control, statement or
+// objective id, e.g. "AC-2 (1)" for "ac-2.1_smt.a".
+func ControlKey(id string) (string, error) {
+	control := strings.SplitN(strings.TrimSpace(id), "_", 2)[0]
+	parts := strings.SplitN(control, ".", 2)
+	control = strings.ToUpper(parts[0])
+	if len(parts) == 2 {
+		control += " (" + parts[1] + ")"
+	}
+	key, err := parser.NewParser().ControlKey(control)
+	if err != nil {
+		return "", fmt.Errorf("unknown control id %q", id)
+	}
+	return key, nil
+}
+
+// statusFromOSCAL maps an OSCAL implementation status back to OpenControl.
+func statusFromOSCAL(state string) string {
+	switch state {
+	case StatusImplemented, "alternative":
+		return parser.StatusComplete
+	case StatusPartial:
+		return parser.StatusPartial
+	case StatusPlanned:
+		return parser.StatusPlanned
+	case StatusNotApplicable:
+		return parser.StatusNotApplicable
+	default:
+		return ""
+	}
+}
+
+// ControlResults converts the findings of every result into assessment
+// results. The status of a control is the implementation status set by the
+// assessor, or complete when the target is satisfied and none when it isn't.
+// Each finding is referenced as a verification, pointing to the evidence of
+// its first observation that has some, or to the document itself. Findings
+// whose target isn't a control are returned as errors.
+func (ar *AssessmentResults) ControlResults(path string) ([]assessment.Result, []error) {
+	var results []assessment.Result
+	var errs []error
+	for _, r := range ar.Results {
+		observations := make(map[string]Observation)
+		for _, o := range r.Observations {
+			observations[o.UUID] = o
+		}
+
+		for _, f := range r.Findings {
+			key, err := ControlKey(f.Target.TargetID)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("finding %s: %v", f.UUID, err))
+				continue
+			}
+
+			res := assessment.Result{
+				ControlKey: key,
+				Verification: common.VerificationReference{
+					Key: "oscal-" + f.UUID,
+					GeneralReference: common.GeneralReference{
+						Name: strings.TrimSpace(f.Title + " (" + f.Target.Status.State + ")"),
+						Path: path,
+						Type: "OSCAL assessment results",
+					},
+				},
+			}
+			if f.Target.ImplementationStatus != nil {
+				res.Status = statusFromOSCAL(f.Target.ImplementationStatus.State)
+			}
+			if res.Status == "" {
+				switch f.Target.Status.State {
+				case "satisfied":
+					res.Status = parser.StatusComplete
+				case "not-satisfied":
+					res.Status = parser.StatusNone
+				}
+			}
+
+		evidence:
+			for _, ro := range f.RelatedObservations {
+				for _, e := range observations[ro.ObservationUUID].RelevantEvidence {
+					if e.Href != "" {
+						res.Verification.Path = e.Href
+						res.Verification.Type = "URL"
+						break evidence
+					}
+				}
+			}
+			results = append(results, res)
+		}
+	}
+	return results, errs
+}
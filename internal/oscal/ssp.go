@@ -0,0 +1,450 @@
+package oscal
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+	"gopkg.in/yaml.v2"
+	"vbom.ml/util/sortorder"
+)
+
+// SystemConfig describes the system a system security plan is written for.
+// It is read from a YAML file maintained next to the sheet.
+type SystemConfig struct {
+	// Title of the document, "<system name> System Security Plan" by default
+	Title           string `yaml:"title"`
+	SystemName      string `yaml:"system-name"`
+	SystemNameShort string `yaml:"system-name-short"`
+	// SystemID identifies the system, the system name by default
+	SystemID    string `yaml:"system-id"`
+	Description string `yaml:"description"`
+	// Categorization is the FIPS 199 level of the system: low, moderate or
+	// high. It is used for every security objective and information type
+	// that doesn't set its own.
+	Categorization        string `yaml:"categorization"`
+	Confidentiality       string `yaml:"confidentiality"`
+	Integrity             string `yaml:"integrity"`
+	Availability          string `yaml:"availability"`
+	Status                string `yaml:"status"`
+	AuthorizationBoundary string `yaml:"authorization-boundary"`
+	// Profile is the baseline profile the plan implements, the NIST 800-53
+	// rev5 baseline of the categorization by default
+	Profile          string            `yaml:"profile"`
+	InformationTypes []InformationType `yaml:"information-types"`
+	Roles            []Role            `yaml:"roles"`
+	Parties          []SystemParty     `yaml:"parties"`
+}
+
+// InformationType is a type of information processed by the system, as
+// defined by NIST SP 800-60.
+type InformationType struct {
+	Title           string `yaml:"title"`
+	Description     string `yaml:"description"`
+	Confidentiality string `yaml:"confidentiality"`
+	Integrity       string `yaml:"integrity"`
+	Availability    string `yaml:"availability"`
+}
+
+// SystemParty is a person or organization responsible for some roles.
+type SystemParty struct {
+	Name  string   `yaml:"name"`
+	Type  string   `yaml:"type"`
+	Email string   `yaml:"email"`
+	Roles []string `yaml:"roles"`
+}
+
+// LoadSystemConfig reads a system config file and fills in the defaults.
+func LoadSystemConfig(path string) (SystemConfig, error) {
+	var cfg SystemConfig
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err := yaml.UnmarshalStrict(b, &cfg); err != nil {
+		return cfg, fmt.Errorf("%s: %v", path, err)
+	}
+	if err := cfg.complete(); err != nil {
+		return cfg, fmt.Errorf("%s: %v", path, err)
+	}
+	return cfg, nil
+}
+
+var impactLevels = map[string]bool{"low": true, "moderate": true, "high": true}
+
+// complete checks the config and fills in the defaults.
+func (c *SystemConfig) complete() error {
+	if c.SystemName == "" {
+		return fmt.Errorf("system-name is required")
+	}
+	c.Categorization = strings.ToLower(c.Categorization)
+	if !impactLevels[c.Categorization] {
+		return fmt.Errorf("categorization must be low, moderate or high, got %q", c.Categorization)
+	}
+	levels := []*string{&c.Confidentiality, &c.Integrity, &c.Availability}
+	for i := range c.InformationTypes {
+		it := &c.InformationTypes[i]
+		levels = append(levels, &it.Confidentiality, &it.Integrity, &it.Availability)
+	}
+	for _, l := range levels {
+		*l = strings.ToLower(*l)
+		if *l == "" {
+			*l = c.Categorization
+		}
+		if !impactLevels[*l] {
+			return fmt.Errorf("impact levels must be low, moderate or high, got %q", *l)
+		}
+	}
+
+	if c.Title == "" {
+		c.Title = c.SystemName + " System Security Plan"
+	}
+	if c.SystemID == "" {
+		c.SystemID = c.SystemName
+	}
+	if c.Description == "" {
+		c.Description = c.SystemName
+	}
+	if c.Status == "" {
+		c.Status = "operational"
+	}
+	if c.AuthorizationBoundary == "" {
+		return fmt.Errorf("authorization-boundary is required")
+	}
+	if c.Profile == "" {
+		c.Profile = BaselineProfile(c.Categorization)
+	}
+	if len(c.InformationTypes) == 0 {
+		c.InformationTypes = []InformationType{{
+			Title:           c.SystemName,
+			Description:     "Information processed by " + c.SystemName,
+			Confidentiality: c.Confidentiality,
+			Integrity:       c.Integrity,
+			Availability:    c.Availability,
+		}}
+	}
+
+	roles := make(map[string]bool)
+	for _, r := range c.Roles {
+		roles[r.ID] = true
+	}
+	for i, p := range c.Parties {
+		if p.Type == "" {
+			c.Parties[i].Type = "person"
+		}
+		for _, r := range p.Roles {
+			if !roles[r] {
+				return fmt.Errorf("party %s has unknown role %q", p.Name, r)
+			}
+		}
+	}
+	return nil
+}
+
+// BaselineProfile returns the NIST 800-53 rev5 baseline profile of a FIPS 199
+// level.
+func BaselineProfile(level string) string {
+	return fmt.Sprintf("https://raw.githubusercontent.com/usnistgov/oscal-content/main/nist.gov/SP800-53/rev5/json/NIST_SP-800-53_rev5_%s-baseline_profile.json", strings.ToUpper(level))
+}
+
+func fips199(level string) string {
+	return "fips-199-" + level
+}
+
+// SSP is an OSCAL system-security-plan document.
+type SSP struct {
+	UUID                  string                `json:"uuid"`
+	Metadata              Metadata              `json:"metadata"`
+	ImportProfile         ImportProfile         `json:"import-profile"`
+	SystemCharacteristics SystemCharacteristics `json:"system-characteristics"`
+	SystemImplementation  SystemImplementation  `json:"system-implementation"`
+	ControlImplementation SSPControls           `json:"control-implementation"`
+}
+
+// ImportProfile references the baseline the plan implements.
+type ImportProfile struct {
+	Href string `json:"href"`
+}
+
+// SystemCharacteristics describes the system.
+type SystemCharacteristics struct {
+	SystemIDs                []SystemID            `json:"system-ids"`
+	SystemName               string                `json:"system-name"`
+	SystemNameShort          string                `json:"system-name-short,omitempty"`
+	Description              string                `json:"description"`
+	SecuritySensitivityLevel string                `json:"security-sensitivity-level"`
+	SystemInformation        SystemInformation     `json:"system-information"`
+	SecurityImpactLevel      SecurityImpactLevel   `json:"security-impact-level"`
+	Status                   State                 `json:"status"`
+	AuthorizationBoundary    AuthorizationBoundary `json:"authorization-boundary"`
+}
+
+// SystemID is an identifier of the system.
+type SystemID struct {
+	IdentifierType string `json:"identifier-type,omitempty"`
+	ID             string `json:"id"`
+}
+
+// SystemInformation lists the types of information processed by the system.
+type SystemInformation struct {
+	InformationTypes []SSPInformationType `json:"information-types"`
+}
+
+// SSPInformationType is an information type and its impact levels.
+type SSPInformationType struct {
+	UUID                  string `json:"uuid"`
+	Title                 string `json:"title"`
+	Description           string `json:"description"`
+	ConfidentialityImpact Impact `json:"confidentiality-impact"`
+	IntegrityImpact       Impact `json:"integrity-impact"`
+	AvailabilityImpact    Impact `json:"availability-impact"`
+}
+
+// Impact is the impact level of a security objective.
+type Impact struct {
+	Base string `json:"base"`
+}
+
+// SecurityImpactLevel is the impact level of the system.
+type SecurityImpactLevel struct {
+	Confidentiality string `json:"security-objective-confidentiality"`
+	Integrity       string `json:"security-objective-integrity"`
+	Availability    string `json:"security-objective-availability"`
+}
+
+// State is the status of a system or component.
+type State struct {
+	State string `json:"state"`
+}
+
+// AuthorizationBoundary describes what the authorization covers.
+type AuthorizationBoundary struct {
+	Description string `json:"description"`
+}
+
+// SystemImplementation lists the users and components of the system.
+type SystemImplementation struct {
+	Users      []SystemUser      `json:"users"`
+	Components []SystemComponent `json:"components"`
+}
+
+// SystemUser is a type of user of the system.
+type SystemUser struct {
+	UUID    string   `json:"uuid"`
+	Title   string   `json:"title,omitempty"`
+	RoleIDs []string `json:"role-ids,omitempty"`
+}
+
+// SystemComponent is a component of the system.
+type SystemComponent struct {
+	UUID        string `json:"uuid"`
+	Type        string `json:"type"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Status      State  `json:"status"`
+}
+
+// SSPControls describes how the system implements its controls.
+type SSPControls struct {
+	Description             string                      `json:"description"`
+	ImplementedRequirements []SSPImplementedRequirement `json:"implemented-requirements"`
+}
+
+// SSPImplementedRequirement describes how the components of the system
+// implement a control.
+type SSPImplementedRequirement struct {
+	UUID         string         `json:"uuid"`
+	ControlID    string         `json:"control-id"`
+	Statements   []SSPStatement `json:"statements,omitempty"`
+	ByComponents []ByComponent  `json:"by-components,omitempty"`
+}
+
+// SSPStatement describes how the components implement a part of a control.
+type SSPStatement struct {
+	StatementID  string        `json:"statement-id"`
+	UUID         string        `json:"uuid"`
+	ByComponents []ByComponent `json:"by-components"`
+}
+
+// ByComponent is the narrative of a component for a control or statement.
+type ByComponent struct {
+	ComponentUUID        string            `json:"component-uuid"`
+	UUID                 string            `json:"uuid"`
+	Description          string            `json:"description"`
+	Props                []Property        `json:"props,omitempty"`
+	ImplementationStatus *State            `json:"implementation-status,omitempty"`
+	ResponsibleRoles     []ResponsibleRole `json:"responsible-roles,omitempty"`
+}
+
+// NewSSP builds a system-security-plan out of the system config and the
+// components of the system. Each narrative of a component becomes a
+// by-component entry of the statement matching its narrative key.
+func NewSSP(cfg SystemConfig, components []*v3c.Component, modified time.Time) SSP {
+	system := cfg.SystemID
+	ssp := SSP{
+		UUID: UUID("system-security-plan", system),
+		Metadata: Metadata{
+			Title:        cfg.Title,
+			LastModified: modified.UTC().Truncate(time.Second),
+			Version:      modified.UTC().Format("2006-01-02"),
+			OSCALVersion: Version,
+			Roles:        cfg.Roles,
+		},
+		ImportProfile: ImportProfile{Href: cfg.Profile},
+		SystemCharacteristics: SystemCharacteristics{
+			SystemIDs:                []SystemID{{ID: cfg.SystemID}},
+			SystemName:               cfg.SystemName,
+			SystemNameShort:          cfg.SystemNameShort,
+			Description:              cfg.Description,
+			SecuritySensitivityLevel: fips199(cfg.Categorization),
+			SecurityImpactLevel: SecurityImpactLevel{
+				Confidentiality: fips199(cfg.Confidentiality),
+				Integrity:       fips199(cfg.Integrity),
+				Availability:    fips199(cfg.Availability),
+			},
+			Status:                State{State: cfg.Status},
+			AuthorizationBoundary: AuthorizationBoundary{Description: cfg.AuthorizationBoundary},
+		},
+		ControlImplementation: SSPControls{
+			Description: "Controls implemented by the components of " + cfg.SystemName,
+		},
+	}
+
+	for _, it := range cfg.InformationTypes {
+		ssp.SystemCharacteristics.SystemInformation.InformationTypes = append(ssp.SystemCharacteristics.SystemInformation.InformationTypes, SSPInformationType{
+			UUID:                  UUID("information-type", system, it.Title),
+			Title:                 it.Title,
+			Description:           it.Description,
+			ConfidentialityImpact: Impact{Base: fips199(it.Confidentiality)},
+			IntegrityImpact:       Impact{Base: fips199(it.Integrity)},
+			AvailabilityImpact:    Impact{Base: fips199(it.Availability)},
+		})
+	}
+
+	partiesByRole := make(map[string][]string)
+	for _, p := range cfg.Parties {
+		party := Party{UUID: UUID("party", p.Name), Type: p.Type, Name: p.Name}
+		if p.Email != "" {
+			party.Emails = []string{p.Email}
+		}
+		ssp.Metadata.Parties = append(ssp.Metadata.Parties, party)
+		for _, r := range p.Roles {
+			partiesByRole[r] = append(partiesByRole[r], party.UUID)
+		}
+	}
+	for _, r := range cfg.Roles {
+		if uuids := partiesByRole[r.ID]; len(uuids) > 0 {
+			ssp.Metadata.ResponsibleParties = append(ssp.Metadata.ResponsibleParties, ResponsibleParty{RoleID: r.ID, PartyUUIDs: uuids})
+		}
+		ssp.SystemImplementation.Users = append(ssp.SystemImplementation.Users, SystemUser{
+			UUID:    UUID("user", system, r.ID),
+			Title:   r.Title,
+			RoleIDs: []string{r.ID},
+		})
+	}
+	if len(ssp.SystemImplementation.Users) == 0 {
+		ssp.SystemImplementation.Users = []SystemUser{{UUID: UUID("user", system), Title: "System user"}}
+	}
+
+	ssp.SystemImplementation.Components = append(ssp.SystemImplementation.Components, SystemComponent{
+		UUID:        UUID("this-system", system),
+		Type:        "this-system",
+		Title:       cfg.SystemName,
+		Description: cfg.Description,
+		Status:      State{State: "operational"},
+	})
+	for _, c := range components {
+		ssp.SystemImplementation.Components = append(ssp.SystemImplementation.Components, SystemComponent{
+			UUID:        UUID("component", c.Name),
+			Type:        "software",
+			Title:       c.Name,
+			Description: c.Name,
+			Status:      State{State: "operational"},
+		})
+	}
+
+	ssp.ControlImplementation.ImplementedRequirements = sspRequirements(system, components)
+	return ssp
+}
+
+func sspRequirements(system string, components []*v3c.Component) []SSPImplementedRequirement {
+	reqs := make(map[string]*SSPImplementedRequirement)
+	statements := make(map[string]map[string]*SSPStatement)
+	var keys []string
+
+	for _, c := range components {
+		for _, s := range c.Satisfies {
+			ir, ok := reqs[s.ControlKey]
+			if !ok {
+				ir = &SSPImplementedRequirement{
+					UUID:      UUID("ssp-implemented-requirement", system, s.ControlKey),
+					ControlID: ControlID(s.ControlKey),
+				}
+				reqs[s.ControlKey] = ir
+				statements[s.ControlKey] = make(map[string]*SSPStatement)
+				keys = append(keys, s.ControlKey)
+			}
+
+			for _, n := range s.Narrative {
+				bc := byComponent(system, c.Name, s, n)
+				if n.Key == "" {
+					ir.ByComponents = append(ir.ByComponents, bc)
+					continue
+				}
+				st, ok := statements[s.ControlKey][n.Key]
+				if !ok {
+					st = &SSPStatement{
+						StatementID: StatementID(s.ControlKey, n.Key),
+						UUID:        UUID("ssp-statement", system, s.ControlKey, n.Key),
+					}
+					statements[s.ControlKey][n.Key] = st
+				}
+				st.ByComponents = append(st.ByComponents, bc)
+			}
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return sortorder.NaturalLess(keys[i], keys[j])
+	})
+	var out []SSPImplementedRequirement
+	for _, k := range keys {
+		ir := reqs[k]
+		var narrativeKeys []string
+		for nk := range statements[k] {
+			narrativeKeys = append(narrativeKeys, nk)
+		}
+		sort.Slice(narrativeKeys, func(i, j int) bool {
+			return sortorder.NaturalLess(narrativeKeys[i], narrativeKeys[j])
+		})
+		for _, nk := range narrativeKeys {
+			ir.Statements = append(ir.Statements, *statements[k][nk])
+		}
+		out = append(out, *ir)
+	}
+	return out
+}
+
+func byComponent(system, component string, s v3c.Satisfies, n v3c.NarrativeSection) ByComponent {
+	bc := ByComponent{
+		ComponentUUID: UUID("component", component),
+		UUID:          UUID("by-component", system, component, s.ControlKey, n.Key),
+		Description:   strings.TrimSpace(n.Text),
+	}
+	if s.ControlOrigin != "" {
+		bc.Props = []Property{{Name: PropControlOrigination, Value: ControlOrigination(s.ControlOrigin)}}
+	}
+	if status := ImplementationStatus(s.ImplementationStatus); status != "" {
+		bc.ImplementationStatus = &State{State: status}
+	}
+	return bc
+}
+
+// WriteJSON writes the system security plan as OSCAL JSON.
+func (s SSP) WriteJSON(w io.Writer) error {
+	return writeJSON(w, "system-security-plan", s)
+}
@@ -0,0 +1,91 @@
+package oscal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const schemaURL = "oscal_complete_schema.json"
+
+// definitions maps the root property of each document we generate to its
+// definition in the schema.
+var definitions = map[string]string{
+	"component-definition":          "oscal-complete-oscal-component-definition:component-definition",
+	"system-security-plan":          "oscal-complete-oscal-ssp:system-security-plan",
+	"plan-of-action-and-milestones": "oscal-complete-oscal-poam:plan-of-action-and-milestones",
+	"assessment-results":            "oscal-complete-oscal-ar:assessment-results",
+}
+
+var (
+	schemasMu sync.Mutex
+	compiler  *jsonschema.Compiler
+	schemas   = make(map[string]*jsonschema.Schema)
+)
+
+func schemaFor(root string) (*jsonschema.Schema, error) {
+	def, ok := definitions[root]
+	if !ok {
+		return nil, fmt.Errorf("no schema for %q documents", root)
+	}
+
+	schemasMu.Lock()
+	defer schemasMu.Unlock()
+	if s, ok := schemas[root]; ok {
+		return s, nil
+	}
+	if compiler == nil {
+		c := jsonschema.NewCompiler()
+		if err := c.AddResource(schemaURL, strings.NewReader(completeSchema)); err != nil {
+			return nil, err
+		}
+		compiler = c
+	}
+	s, err := compiler.Compile(schemaURL + "#/definitions/" + def)
+	if err != nil {
+		return nil, err
+	}
+	schemas[root] = s
+	return s, nil
+}
+
+// Validate checks the JSON of a document, without its root property, against
+// the OSCAL schema of the root.
+func Validate(root string, doc []byte) error {
+	s, err := schemaFor(root)
+	if err != nil {
+		return err
+	}
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+
+	err = s.Validate(v)
+	verr, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return err
+	}
+	var problems []string
+	for _, leaf := range leaves(verr) {
+		problems = append(problems, fmt.Sprintf("%s: %s", leaf.InstanceLocation, leaf.Message))
+	}
+	return fmt.Errorf("invalid %s:\n  %s", root, strings.Join(problems, "\n  "))
+}
+
+func leaves(e *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(e.Causes) == 0 {
+		return []*jsonschema.ValidationError{e}
+	}
+	var l []*jsonschema.ValidationError
+	for _, c := range e.Causes {
+		l = append(l, leaves(c)...)
+	}
+	return l
+}
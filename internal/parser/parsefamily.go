@@ -23,7 +23,7 @@ func ParseFamily(family string) string {
 		return "MP-Media_Protection"
 	case "PERSONNEL_SECURITY":
 		return "PS-Personnel_Security"
-	case "PHYSICAL_AND_ENVIRONMENTAL PROTECTION":
+	case "PHYSICAL_AND_ENVIRONMENTAL_PROTECTION":
 		return "PE-Physical_and_Environmental_Protection"
 	case "PLANNING":
 		return "PL-Planning"
@@ -4,11 +4,81 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode"
 
 	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
 )
 
-type controlFamily string
+// ControlFamily is the OpenControl friendly name of a NIST family, e.g.
+// "AC-Access_Control".
+type ControlFamily string
+
+// Data holds the parsed controls, keyed by family and then by control key.
+type Data map[ControlFamily]map[string]v3c.Satisfies
+
+// Row is a single entry of the assessment spreadsheet. Only Family and
+// Control are mandatory, the rest of the columns are optional.
+type Row struct {
+	// Line is the spreadsheet row the entry was read from (0 if unknown)
+	Line      int
+	Family    string
+	Control   string
+	Narrative string
+	Status    string
+	Origin    string
+	Owner     string
+	// Evidence holds links to evidence, separated by whitespace, commas or
+	// semicolons
+	Evidence string
+	// Milestone is the date the control is planned to be implemented by
+	Milestone string
+	// Due is the date the control must be implemented by
+	Due string
+	// Automatable tells whether the control can be enforced by a policy,
+	// e.g. "Yes" or "No"
+	Automatable string
+	// Policy holds the paths of the policy templates enforcing the control,
+	// separated like evidence
+	Policy string
+}
+
+// Details holds what the rows of a control carry beyond the OpenControl
+// Satisfies struct.
+type Details struct {
+	Owner    string   `json:"owner,omitempty"`
+	Evidence []string `json:"evidence,omitempty"`
+	// Milestone is the first milestone date of the rows, as YYYY-MM-DD
+	Milestone string `json:"milestone,omitempty"`
+	// Due is the earliest due date of the rows, as YYYY-MM-DD
+	Due string `json:"due,omitempty"`
+	// Automatable is set when a row marks the control as automatable
+	Automatable bool `json:"automatable,omitempty"`
+	// Policies are the policy templates enforcing the control
+	Policies []string `json:"policies,omitempty"`
+	// Lines are the spreadsheet rows the control was read from
+	Lines []int `json:"lines,omitempty"`
+}
+
+// Severities of a Diagnostic.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Diagnostic is a problem found in a row of the spreadsheet.
+type Diagnostic struct {
+	Line     int    `json:"line"`
+	Control  string `json:"control"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	// Owner is the owner cell of the row, so that problems can be routed
+	// even when the row couldn't be parsed
+	Owner string `json:"owner,omitempty"`
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("row %d: %s: %s: %s", d.Line, d.Severity, d.Control, d.Message)
+}
 
 type Parser struct {
 	// whitespace regex
@@ -23,7 +93,9 @@ type Parser struct {
 	subCtrlEnh     *regexp.Regexp
 	// subcontrol with extra enhancements
 	subCtrlEnhPlus *regexp.Regexp
-	data           map[controlFamily]map[string]v3c.Satisfies
+	data           Data
+	details        map[string]Details
+	warnings       []Diagnostic
 }
 
 func NewParser() *Parser {
@@ -41,12 +113,31 @@ func NewParser() *Parser {
 		// subcontrol with additional enhancements ([1] control family [2] control number [3] sub-control 
 		// [4] enhancement + [5] additional_enhancement)
 		subCtrlEnhPlus: regexp.MustCompile(`^([A-Z]+)-([0-9]+) (\([0-9]+\))\(([a-z])\)\(([0-9]+)\)$`),
-		data:       make(map[controlFamily]map[string]v3c.Satisfies),
+		data:       make(Data),
+		details:    make(map[string]Details),
 	}
 }
 
+// ParseEntry parses a family and control pair that has no extra columns.
 func (p *Parser) ParseEntry(family, control string) error {
-	nfamily := p.normalizeFamily(family)
+	return p.ParseRow(Row{Family: family, Control: control})
+}
+
+// ParseRow parses a spreadsheet row and merges it into the parsed data.
+func (p *Parser) ParseRow(row Row) error {
+	parsedCtrl, err := p.parseControl(row.Control)
+	if err != nil {
+		return err
+	}
+	applyColumns(&parsedCtrl, row)
+
+	nfamily := p.normalizeFamily(row.Family)
+	if nfamily == "" {
+		p.warn(row, "unknown family %q", row.Family)
+	}
+	if s := parsedCtrl.ImplementationStatus; s != "" && !KnownStatus(s) {
+		p.warn(row, "unknown implementation status %q", row.Status)
+	}
 
 	ctrls, foundFam := p.data[nfamily]
 
@@ -55,11 +146,14 @@ func (p *Parser) ParseEntry(family, control string) error {
 		ctrls = make(map[string]v3c.Satisfies)
 		p.data[nfamily] = ctrls
 	}
-	
-	parsedCtrl, err := p.parseControl(control)
-	if err != nil {
-		return err
+
+	milestone := p.parseDateColumn(row, "milestone", row.Milestone)
+	due := p.parseDateColumn(row, "due", row.Due)
+	automatable, ok := parseYesNo(row.Automatable)
+	if !ok {
+		p.warn(row, "invalid automatable value %q", row.Automatable)
 	}
+	p.addDetails(parsedCtrl.ControlKey, row, milestone, due, automatable)
 
 	storedCtrl, foundCtrl := ctrls[parsedCtrl.ControlKey]
 
@@ -72,12 +166,86 @@ func (p *Parser) ParseEntry(family, control string) error {
 	return nil
 }
 
+// parseDateColumn returns a date cell as YYYY-MM-DD, warning about the
+// dates it can't parse.
+func (p *Parser) parseDateColumn(row Row, column, value string) string {
+	if value == "" {
+		return ""
+	}
+	t, err := ParseDate(value)
+	if err != nil {
+		p.warn(row, "invalid %s date %q", column, value)
+		return ""
+	}
+	return t.Format(DateFormat)
+}
+
+func (p *Parser) addDetails(controlKey string, row Row, milestone, due string, automatable bool) {
+	d := p.details[controlKey]
+	if d.Owner == "" {
+		d.Owner = strings.TrimSpace(row.Owner)
+	}
+	if d.Milestone == "" {
+		d.Milestone = milestone
+	}
+	if due != "" && (d.Due == "" || due < d.Due) {
+		d.Due = due
+	}
+	d.Automatable = d.Automatable || automatable
+	d.Evidence = append(d.Evidence, splitEvidence(row.Evidence)...)
+	d.Policies = append(d.Policies, splitEvidence(row.Policy)...)
+	if row.Line > 0 {
+		d.Lines = append(d.Lines, row.Line)
+	}
+	p.details[controlKey] = d
+}
+
+func splitEvidence(evidence string) []string {
+	return strings.FieldsFunc(evidence, func(r rune) bool {
+		return r == ',' || r == ';' || unicode.IsSpace(r)
+	})
+}
+
+// parseYesNo parses a yes/no cell, an empty cell being no. It returns false
+// for values it doesn't understand.
+func parseYesNo(value string) (yes, ok bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "yes", "y", "true", "x":
+		return true, true
+	case "", "no", "n", "false":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// GetDetails returns the details of the parsed controls, keyed by control key.
+func (p *Parser) GetDetails() map[string]Details {
+	return p.details
+}
+
+func (p *Parser) warn(row Row, format string, args ...interface{}) {
+	p.warnings = append(p.warnings, Diagnostic{
+		Line:     row.Line,
+		Control:  row.Control,
+		Severity: SeverityWarning,
+		Message:  fmt.Sprintf(format, args...),
+		Owner:    strings.TrimSpace(row.Owner),
+	})
+}
+
+// Warnings returns the problems found in the rows parsed so far that didn't
+// prevent them from being parsed.
+func (p *Parser) Warnings() []Diagnostic {
+	return p.warnings
+}
+
 // normalizeFamily normalizes the family name into something more
 // fitting for OpenControl.
-func (p *Parser) normalizeFamily(family string) controlFamily {
+func (p *Parser) normalizeFamily(family string) ControlFamily {
 	// Ensure we take care of whitespace issues
 	nFamily := p.wre.ReplaceAllString(family, "_")
-	return controlFamily(ParseFamily(nFamily))
+	return ControlFamily(ParseFamily(nFamily))
 }
 
 // parseControl parses a NIST 800-53 control and ensures it conforms to the 
@@ -118,19 +286,38 @@ func (p *Parser) parseControl(control string) (v3c.Satisfies, error) {
 	}
 }
 
-func (p *Parser) GetData() map[controlFamily]map[string]v3c.Satisfies {
+// ControlKey returns the key of the control an entry of the sheet refers to,
+// e.g. "AC-2" for "AC-2a.".
+func (p *Parser) ControlKey(control string) (string, error) {
+	ctrl, err := p.parseControl(control)
+	return ctrl.ControlKey, err
+}
+
+// Narrative returns the key of the control of a row and the narrative the
+// row contributes to it, which is a placeholder when the row has no text.
+func (p *Parser) Narrative(row Row) (string, v3c.NarrativeSection, error) {
+	ctrl, err := p.parseControl(row.Control)
+	if err != nil {
+		return "", v3c.NarrativeSection{}, err
+	}
+	applyColumns(&ctrl, row)
+	return ctrl.ControlKey, ctrl.Narrative[0], nil
+}
+
+func (p *Parser) GetData() Data {
 	removeNarrative(p.data)
 	return p.data
 }
 
 // removeNarrative removes the first text Narrative from controls with additional controls
 // and enhancements. Fixes https://github.com/carlosmmatos/automate-compliance/issues/12
-func removeNarrative(p map[controlFamily]map[string]v3c.Satisfies) {
+func removeNarrative(p Data) {
 	for _, ctrl := range p {
 		for _, v := range ctrl {
-			if len(v.Narrative) > 1 {
+			if len(v.Narrative) > 1 && v.Narrative[0].Key == "" {
 				// when length of Narrative > 1, we know we can safely remove the first
-				// index, which would be for the primary control key.
+				// index, which would be for the primary control key. The key check
+				// keeps this idempotent across calls to GetData.
 				ctrl[v.ControlKey] = remove(v)
 			}
 		}
@@ -151,10 +338,28 @@ func getSubControlKey(matches []string) string {
 	return fmt.Sprintf("%s-%s %s", matches[1], matches[2], matches[3])
 }
 
+// Placeholder narratives used when the sheet doesn't provide the text.
+const (
+	PlaceholderTextOnly        = "Text only"
+	PlaceholderEnhancement     = "Text for enhancement"
+	PlaceholderEnhancementPlus = "Text for enhancement plus"
+)
+
+// IsPlaceholder returns true if the narrative text is empty or one of the
+// placeholders set by the parser.
+func IsPlaceholder(text string) bool {
+	switch strings.TrimSpace(text) {
+	case "", PlaceholderTextOnly, PlaceholderEnhancement, PlaceholderEnhancementPlus:
+		return true
+	default:
+		return false
+	}
+}
+
 func getTextOnlyNarrative() v3c.NarrativeSection {
 	// TODO(jaosorior): get text from spreadsheet
 	return v3c.NarrativeSection{
-		Text: "Text only",
+		Text: PlaceholderTextOnly,
 	}
 }
 
@@ -162,7 +367,7 @@ func getNarrativeForEnhancement(enhancement string) v3c.NarrativeSection {
 	// TODO(jaosorior): get text from spreadsheet
 	return v3c.NarrativeSection{
 		Key:  normalizeEnhancementKey(enhancement),
-		Text: "Text for enhancement",
+		Text: PlaceholderEnhancement,
 	}
 }
 
@@ -170,10 +375,16 @@ func getNarrativeForEnhancementPlus(matches []string) v3c.NarrativeSection {
 	// handles use case: AC-3 (3)(b)(2)
 	return v3c.NarrativeSection{
 		Key: normalizeEnhancementPlusKey(matches),
-		Text: "Text for enhancement plus",
+		Text: PlaceholderEnhancementPlus,
 	}
 }
 
+// IsEnhancement returns true if the control key is a control enhancement,
+// e.g. "AC-2 (1)", rather than a base control.
+func IsEnhancement(controlKey string) bool {
+	return strings.Contains(controlKey, "(")
+}
+
 func normalizeEnhancementKey(e string) string {
 	return strings.TrimRight(e, ".")
 }
@@ -183,10 +394,21 @@ func normalizeEnhancementPlusKey(matches []string) string {
 	return fmt.Sprintf("%s.%s", matches[4], matches[5])
 }
 
+// applyColumns fills in the optional columns of a row on a parsed control.
+func applyColumns(ctrl *v3c.Satisfies, row Row) {
+	if text := strings.TrimSpace(row.Narrative); text != "" {
+		ctrl.Narrative[0].Text = text
+	}
+	ctrl.ImplementationStatus = NormalizeStatus(row.Status)
+	ctrl.ControlOrigin = NormalizeOrigin(row.Origin)
+}
+
 func mergeControls(old, new v3c.Satisfies) v3c.Satisfies {
 	// The controlKey is the same so we don't need to merge these.
-
-	// TODO(jaosorior): Gotta handle implementation status
+	old.ImplementationStatus = mergeStatus(old.ImplementationStatus, new.ImplementationStatus)
+	if old.ControlOrigin == "" {
+		old.ControlOrigin = new.ControlOrigin
+	}
 
 	old.Narrative = append(old.Narrative, new.Narrative...)
 	return old
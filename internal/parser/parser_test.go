@@ -24,13 +24,16 @@ func TestParser_normalizeFamily(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want controlFamily
+		want ControlFamily
 	}{
 		{
-			"simple family", args{"ACCESS CONTROL"}, controlFamily("AC-Access_Control"),
+			"simple family", args{"ACCESS CONTROL"}, ControlFamily("AC-Access_Control"),
 		},
 		{
-			"family with extra spaces", args{"ACCESS   CONTROL"}, controlFamily("AC-Access_Control"),
+			"family with extra spaces", args{"ACCESS   CONTROL"}, ControlFamily("AC-Access_Control"),
+		},
+		{
+			"family with several words", args{"PHYSICAL AND ENVIRONMENTAL PROTECTION"}, ControlFamily("PE-Physical_and_Environmental_Protection"),
 		},
 	}
 	for _, tt := range tests {
@@ -154,3 +157,117 @@ func TestParser_parseControl(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_ParseRow(t *testing.T) {
+	p := NewParser()
+	rows := []Row{
+		{Family: "ACCESS CONTROL", Control: "AC-2"},
+		{Family: "ACCESS CONTROL", Control: "AC-2a.", Narrative: "Accounts are reviewed", Status: "Implemented", Origin: "Service Provider Corporate"},
+		{Family: "ACCESS CONTROL", Control: "AC-2b.", Status: "Planned", Owner: "jdoe", Evidence: "https://a.example, https://b.example", Milestone: "9/30/2021", Due: "2021-12-31", Automatable: "Yes", Policy: "policies/accounts.yaml"},
+		{Family: "ACCESS CONTROL", Control: "AC-2c.", Due: "11/30/2021"},
+	}
+	for _, row := range rows {
+		if err := p.ParseRow(row); err != nil {
+			t.Fatalf("Parser.ParseRow() error = %v", err)
+		}
+	}
+
+	want := v3c.Satisfies{
+		ControlKey: "AC-2",
+		Narrative: []v3c.NarrativeSection{
+			{Key: "a", Text: "Accounts are reviewed"},
+			{Key: "b", Text: "Text for enhancement"},
+			{Key: "c", Text: "Text for enhancement"},
+		},
+		ControlOrigin:        "service_provider_corporate",
+		ImplementationStatus: StatusPartial,
+	}
+	// GetData must be safe to call more than once
+	p.GetData()
+	if got := p.GetData()["AC-Access_Control"]["AC-2"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("Parser.GetData() = %v, want %v", got, want)
+	}
+
+	wantDetails := Details{Owner: "jdoe", Evidence: []string{"https://a.example", "https://b.example"}, Milestone: "2021-09-30", Due: "2021-11-30", Automatable: true, Policies: []string{"policies/accounts.yaml"}}
+	if got := p.GetDetails()["AC-2"]; !reflect.DeepEqual(got, wantDetails) {
+		t.Errorf("Parser.GetDetails() = %v, want %v", got, wantDetails)
+	}
+}
+
+func TestParser_Warnings(t *testing.T) {
+	p := NewParser()
+	rows := []Row{
+		{Line: 2, Family: "ACCESS CONTROL", Control: "AC-2", Status: "Implemented"},
+		{Line: 3, Family: "ACCESS KONTROL", Control: "AC-3"},
+		{Line: 4, Family: "ACCESS CONTROL", Control: "AC-4", Status: "Mostly"},
+		{Line: 5, Family: "ACCESS CONTROL", Control: "AC-5", Milestone: "next week"},
+		{Line: 6, Family: "ACCESS CONTROL", Control: "AC-6", Automatable: "Maybe"},
+		{Line: 7, Family: "ACCESS CONTROL", Control: "AC-7", Due: "soon"},
+	}
+	for _, row := range rows {
+		if err := p.ParseRow(row); err != nil {
+			t.Fatalf("Parser.ParseRow() error = %v", err)
+		}
+	}
+
+	want := []Diagnostic{
+		{Line: 3, Control: "AC-3", Severity: SeverityWarning, Message: `unknown family "ACCESS KONTROL"`},
+		{Line: 4, Control: "AC-4", Severity: SeverityWarning, Message: `unknown implementation status "Mostly"`},
+		{Line: 5, Control: "AC-5", Severity: SeverityWarning, Message: `invalid milestone date "next week"`},
+		{Line: 6, Control: "AC-6", Severity: SeverityWarning, Message: `invalid automatable value "Maybe"`},
+		{Line: 7, Control: "AC-7", Severity: SeverityWarning, Message: `invalid due date "soon"`},
+	}
+	if got := p.Warnings(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Parser.Warnings() = %v, want %v", got, want)
+	}
+}
+
+func TestParser_ControlKey(t *testing.T) {
+	tests := []struct {
+		control string
+		want    string
+		wantErr bool
+	}{
+		{"AC-2", "AC-2", false},
+		{"AC-2a.", "AC-2", false},
+		{"AC-2  (1)", "AC-2 (1)", false},
+		{"AC-3 (3)(b)(2)", "AC-3 (3)", false},
+		{"ac-2", "", true},
+	}
+	p := NewParser()
+	for _, tt := range tests {
+		t.Run(tt.control, func(t *testing.T) {
+			got, err := p.ControlKey(tt.control)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parser.ControlKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parser.ControlKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParser_Narrative(t *testing.T) {
+	tests := []struct {
+		row     Row
+		wantKey string
+		want    v3c.NarrativeSection
+	}{
+		{Row{Control: "AC-2"}, "AC-2", v3c.NarrativeSection{Text: PlaceholderTextOnly}},
+		{Row{Control: "AC-2a.", Narrative: " Accounts are reviewed. "}, "AC-2", v3c.NarrativeSection{Key: "a", Text: "Accounts are reviewed."}},
+		{Row{Control: "AC-3 (3)(b)(2)"}, "AC-3 (3)", v3c.NarrativeSection{Key: "b.2", Text: PlaceholderEnhancementPlus}},
+	}
+	p := NewParser()
+	for _, tt := range tests {
+		t.Run(tt.row.Control, func(t *testing.T) {
+			key, got, err := p.Narrative(tt.row)
+			if err != nil {
+				t.Fatalf("Parser.Narrative() error = %v", err)
+			}
+			if key != tt.wantKey || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parser.Narrative() = %v, %v, want %v, %v", key, got, tt.wantKey, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Implementation statuses as defined by the OpenControl component schema.
+const (
+	StatusComplete      = "complete"
+	StatusPartial       = "partial"
+	StatusPlanned       = "planned"
+	StatusNone          = "none"
+	StatusNotApplicable = "not applicable"
+)
+
+// NormalizeStatus takes the free-form status typed in the spreadsheet and
+// returns the matching OpenControl implementation status. Unknown values are
+// returned lower-cased so they are still visible in the output.
+func NormalizeStatus(status string) string {
+	s := strings.ToLower(strings.Join(strings.Fields(status), " "))
+	switch s {
+	case "":
+		return ""
+	case "complete", "completed", "implemented", "done", "yes":
+		return StatusComplete
+	case "partial", "partially implemented", "partially complete", "in progress":
+		return StatusPartial
+	case "planned", "planning", "not started", "todo":
+		return StatusPlanned
+	case "none", "no", "not implemented", "unsatisfied":
+		return StatusNone
+	case "not applicable", "n/a", "na":
+		return StatusNotApplicable
+	default:
+		return s
+	}
+}
+
+// KnownStatus returns true if the status is one of the OpenControl
+// implementation statuses.
+func KnownStatus(status string) bool {
+	switch status {
+	case StatusComplete, StatusPartial, StatusPlanned, StatusNone, StatusNotApplicable:
+		return true
+	default:
+		return false
+	}
+}
+
+// NormalizeOrigin takes the control origin typed in the spreadsheet and
+// returns it in the snake_case form used by OpenControl, e.g.
+// "Service Provider Corporate" becomes "service_provider_corporate".
+func NormalizeOrigin(origin string) string {
+	o := strings.ToLower(strings.TrimSpace(origin))
+	o = strings.NewReplacer("-", " ", "_", " ").Replace(o)
+	return strings.Join(strings.Fields(o), "_")
+}
+
+// mergeStatus combines the statuses of two rows that belong to the same
+// control key.
+func mergeStatus(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "", a == b:
+		return a
+	case a == StatusNotApplicable:
+		return b
+	case b == StatusNotApplicable:
+		return a
+	case a == StatusPlanned && b == StatusNone, a == StatusNone && b == StatusPlanned:
+		return StatusPlanned
+	default:
+		// at least one of the rows made progress while another didn't
+		return StatusPartial
+	}
+}
+
+// DateFormat is the format dates are normalized to.
+const DateFormat = "2006-01-02"
+
+// dateFormats are the date formats accepted in the sheet.
+var dateFormats = []string{
+	DateFormat,
+	"2006/01/02",
+	"1/2/2006",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"2 Jan 2006",
+	"2 January 2006",
+}
+
+// ParseDate parses a date typed in the sheet.
+func ParseDate(date string) (time.Time, error) {
+	date = strings.Join(strings.Fields(date), " ")
+	for _, f := range dateFormats {
+		if t, err := time.Parse(f, date); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unknown date format %q", date)
+}
@@ -0,0 +1,70 @@
+package parser
+
+import "testing"
+
+func TestNormalizeStatus(t *testing.T) {
+	tests := []struct {
+		status string
+		want   string
+	}{
+		{"", ""},
+		{"Implemented", StatusComplete},
+		{"  Partially   implemented ", StatusPartial},
+		{"Not started", StatusPlanned},
+		{"Not Implemented", StatusNone},
+		{"N/A", StatusNotApplicable},
+		{"Alternative", "alternative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			if got := NormalizeStatus(tt.status); got != tt.want {
+				t.Errorf("NormalizeStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_mergeStatus(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{"", StatusPlanned, StatusPlanned},
+		{StatusComplete, StatusComplete, StatusComplete},
+		{StatusComplete, StatusNotApplicable, StatusComplete},
+		{StatusPlanned, StatusNone, StatusPlanned},
+		{StatusComplete, StatusPlanned, StatusPartial},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"+"+tt.b, func(t *testing.T) {
+			if got := mergeStatus(tt.a, tt.b); got != tt.want {
+				t.Errorf("mergeStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		date    string
+		want    string
+		wantErr bool
+	}{
+		{"2021-09-30", "2021-09-30", false},
+		{"9/30/2021", "2021-09-30", false},
+		{"Sep 30, 2021", "2021-09-30", false},
+		{" 30  September 2021 ", "2021-09-30", false},
+		{"Q3 2021", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			got, err := ParseDate(tt.date)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.Format(DateFormat) != tt.want {
+				t.Errorf("ParseDate() = %v, want %v", got.Format(DateFormat), tt.want)
+			}
+		})
+	}
+}
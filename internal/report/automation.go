@@ -0,0 +1,115 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/content"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// ControlRules lists the automated rules referencing a control.
+type ControlRules struct {
+	ControlKey string   `json:"control_key"`
+	Rules      []string `json:"rules"`
+}
+
+// FamilyAutomation counts the controls of a family without automated rules.
+type FamilyAutomation struct {
+	Family    string `json:"family"`
+	Controls  int    `json:"controls"`
+	Uncovered int    `json:"uncovered"`
+}
+
+// Automation maps the controls of the assessment to the ComplianceAsCode
+// rules that automate their checks.
+type Automation struct {
+	Controls int            `json:"controls"`
+	Covered  []ControlRules `json:"covered"`
+	// Uncovered are the controls without any automated rule
+	Uncovered []string `json:"uncovered"`
+	// Families are sorted by number of uncovered controls, so the families
+	// where new content would help the most come first
+	Families []FamilyAutomation `json:"families"`
+}
+
+// NewAutomation computes the automated coverage of the parsed data.
+func NewAutomation(data parser.Data, rules []content.Rule) Automation {
+	byControl := make(map[string][]string)
+	for _, r := range rules {
+		for _, key := range r.Controls {
+			byControl[key] = append(byControl[key], r.ID)
+		}
+	}
+
+	a := Automation{Covered: []ControlRules{}, Uncovered: []string{}, Families: []FamilyAutomation{}}
+	for family, ctrls := range data {
+		fa := FamilyAutomation{Family: string(family)}
+		for key := range ctrls {
+			a.Controls++
+			fa.Controls++
+			if ids := byControl[key]; len(ids) > 0 {
+				a.Covered = append(a.Covered, ControlRules{ControlKey: key, Rules: ids})
+			} else {
+				a.Uncovered = append(a.Uncovered, key)
+				fa.Uncovered++
+			}
+		}
+		a.Families = append(a.Families, fa)
+	}
+
+	sort.Slice(a.Covered, func(i, j int) bool {
+		return sortorder.NaturalLess(a.Covered[i].ControlKey, a.Covered[j].ControlKey)
+	})
+	sortKeys(a.Uncovered)
+	sort.Slice(a.Families, func(i, j int) bool {
+		if a.Families[i].Uncovered != a.Families[j].Uncovered {
+			return a.Families[i].Uncovered > a.Families[j].Uncovered
+		}
+		return a.Families[i].Family < a.Families[j].Family
+	})
+	return a
+}
+
+// WriteText writes the rules of every covered control followed by the
+// uncovered controls.
+func (a Automation) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "Automated: %d of %d controls (%.1f%%)\n\n", len(a.Covered), a.Controls, percent(len(a.Covered), a.Controls))
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CONTROL\tRULES")
+	for _, c := range a.Covered {
+		fmt.Fprintf(tw, "%s\t%s\n", c.ControlKey, strings.Join(c.Rules, ", "))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	return a.WriteUncovered(w)
+}
+
+// WriteUncovered writes the number of uncovered controls per family and the
+// uncovered controls.
+func (a Automation) WriteUncovered(w io.Writer) error {
+	fmt.Fprintf(w, "\nWithout automated rules per family\n")
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "FAMILY\tUNCOVERED\tCONTROLS")
+	for _, f := range a.Families {
+		fmt.Fprintf(tw, "%s\t%d\t%d\n", f.Family, f.Uncovered, f.Controls)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	return writeList(w, "Without automated rules", a.Uncovered)
+}
+
+// WriteJSON writes the automation coverage as JSON.
+func (a Automation) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(a)
+}
@@ -0,0 +1,33 @@
+package report
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/carlosmmatos/automate-compliance/internal/content"
+)
+
+func TestNewAutomation(t *testing.T) {
+	rules := []content.Rule{
+		{ID: "accounts_tmout", Controls: []string{"AC-2 (5)", "AC-12"}},
+		{ID: "account_disable_post_pw_expiration", Controls: []string{"AC-2 (3)", "IA-4"}},
+		{ID: "audit_rules_login_events", Controls: []string{"AU-2", "AC-2"}},
+	}
+	got := NewAutomation(testData(), rules)
+
+	want := Automation{
+		Controls: 4,
+		Covered: []ControlRules{
+			{ControlKey: "AC-2", Rules: []string{"audit_rules_login_events"}},
+			{ControlKey: "AU-2", Rules: []string{"audit_rules_login_events"}},
+		},
+		Uncovered: []string{"AC-2 (1)", "AC-3"},
+		Families: []FamilyAutomation{
+			{Family: "AC-Access_Control", Controls: 3, Uncovered: 2},
+			{Family: "AU-Audit_and_Accountability", Controls: 1, Uncovered: 0},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewAutomation() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,200 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// Total is the family name used for the totals across families.
+const Total = "Total"
+
+// StatusUnset is the key used in status distributions for controls without
+// an implementation status.
+const StatusUnset = "unset"
+
+// StatusOther is the column of the tables counting the controls whose
+// status isn't one of the known ones, see statusColumns.
+const StatusOther = "other"
+
+// statusColumns is the order in which statuses are shown in tables, followed
+// by StatusOther.
+var statusColumns = []string{
+	parser.StatusComplete,
+	parser.StatusPartial,
+	parser.StatusPlanned,
+	parser.StatusNone,
+	parser.StatusNotApplicable,
+	StatusUnset,
+}
+
+// FamilyCoverage summarizes how much of a family is addressed by the
+// assessment.
+type FamilyCoverage struct {
+	Family       string `json:"family"`
+	Controls     int    `json:"controls"`
+	Enhancements int    `json:"enhancements"`
+	Narratives   int    `json:"narratives"`
+	Placeholders int    `json:"placeholders"`
+	// NarrativeCompleteness is the percentage of narratives with real text
+	NarrativeCompleteness float64            `json:"narrative_completeness"`
+	Statuses              map[string]int     `json:"statuses"`
+	StatusPercent         map[string]float64 `json:"status_percent"`
+	// Complete is the percentage of applicable controls and enhancements
+	// that are complete
+	Complete float64 `json:"complete"`
+}
+
+// Coverage is the coverage of every family plus the totals.
+type Coverage struct {
+	Families []FamilyCoverage `json:"families"`
+	Total    FamilyCoverage   `json:"total"`
+}
+
+// NewCoverage computes the coverage of the parsed data.
+func NewCoverage(data parser.Data) Coverage {
+	total := newFamilyCoverage(Total)
+	c := Coverage{Families: []FamilyCoverage{}}
+	for family, ctrls := range data {
+		fc := newFamilyCoverage(string(family))
+		for key, ctrl := range ctrls {
+			for _, cov := range []*FamilyCoverage{&fc, &total} {
+				if parser.IsEnhancement(key) {
+					cov.Enhancements++
+				} else {
+					cov.Controls++
+				}
+				status := ctrl.ImplementationStatus
+				if status == "" {
+					status = StatusUnset
+				}
+				cov.Statuses[status]++
+				for _, n := range ctrl.Narrative {
+					cov.Narratives++
+					if parser.IsPlaceholder(n.Text) {
+						cov.Placeholders++
+					}
+				}
+			}
+		}
+		fc.computePercentages()
+		c.Families = append(c.Families, fc)
+	}
+	sort.Slice(c.Families, func(i, j int) bool {
+		return c.Families[i].Family < c.Families[j].Family
+	})
+	total.computePercentages()
+	c.Total = total
+	return c
+}
+
+func newFamilyCoverage(family string) FamilyCoverage {
+	return FamilyCoverage{
+		Family:        family,
+		Statuses:      make(map[string]int),
+		StatusPercent: make(map[string]float64),
+	}
+}
+
+func (fc *FamilyCoverage) computePercentages() {
+	count := fc.Controls + fc.Enhancements
+	for status, n := range fc.Statuses {
+		fc.StatusPercent[status] = percent(n, count)
+	}
+	fc.NarrativeCompleteness = percent(fc.Narratives-fc.Placeholders, fc.Narratives)
+	fc.Complete = percent(fc.Statuses[parser.StatusComplete], count-fc.Statuses[parser.StatusNotApplicable])
+}
+
+// Other returns the number of controls whose status has no column, i.e. the
+// StatusOther column.
+func (fc FamilyCoverage) Other() int {
+	n := 0
+	for status, count := range fc.Statuses {
+		if !contains(statusColumns, status) {
+			n += count
+		}
+	}
+	return n
+}
+
+func percent(n, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(int64(float64(n)/float64(total)*10000+0.5)) / 100
+}
+
+// hasNarrative returns true if at least one narrative has real text.
+func hasNarrative(n []v3c.NarrativeSection) bool {
+	for _, s := range n {
+		if !parser.IsPlaceholder(s.Text) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Coverage) rows() []FamilyCoverage {
+	return append(append([]FamilyCoverage(nil), c.Families...), c.Total)
+}
+
+// WriteTable writes the coverage as a plain text table.
+func (c Coverage) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "FAMILY\tCONTROLS\tENHANCEMENTS\tNARRATIVES\tWITH TEXT")
+	for _, s := range statusColumns {
+		fmt.Fprintf(tw, "\t%s", strings.ToUpper(s))
+	}
+	fmt.Fprintf(tw, "\t%s\tCOMPLETE\n", strings.ToUpper(StatusOther))
+	for _, fc := range c.rows() {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%.1f%%", fc.Family, fc.Controls, fc.Enhancements, fc.Narratives, fc.NarrativeCompleteness)
+		for _, s := range statusColumns {
+			fmt.Fprintf(tw, "\t%d", fc.Statuses[s])
+		}
+		fmt.Fprintf(tw, "\t%d\t%.1f%%\n", fc.Other(), fc.Complete)
+	}
+	return tw.Flush()
+}
+
+// WriteMarkdown writes the coverage as a Markdown table.
+func (c Coverage) WriteMarkdown(w io.Writer) error {
+	fmt.Fprintf(w, "| Family | Controls | Enhancements | Narratives | With text |")
+	for _, s := range statusColumns {
+		fmt.Fprintf(w, " %s |", s)
+	}
+	fmt.Fprintf(w, " %s | Complete |\n|---|--:|--:|--:|--:|", StatusOther)
+	for range statusColumns {
+		fmt.Fprintf(w, "--:|")
+	}
+	fmt.Fprintf(w, "--:|--:|\n")
+	for _, fc := range c.rows() {
+		family := fc.Family
+		if family == Total {
+			family = "**" + Total + "**"
+		}
+		fmt.Fprintf(w, "| %s | %d | %d | %d | %.1f%% |", family, fc.Controls, fc.Enhancements, fc.Narratives, fc.NarrativeCompleteness)
+		for _, s := range statusColumns {
+			fmt.Fprintf(w, " %d (%.1f%%) |", fc.Statuses[s], fc.StatusPercent[s])
+		}
+		other := fc.Other()
+		fmt.Fprintf(w, " %d (%.1f%%) |", other, percent(other, fc.Controls+fc.Enhancements))
+		if _, err := fmt.Fprintf(w, " %.1f%% |\n", fc.Complete); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the coverage as JSON.
+func (c Coverage) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c)
+}
@@ -0,0 +1,104 @@
+package report
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+func buildControl(key, status string, texts ...string) v3c.Satisfies {
+	s := v3c.Satisfies{ControlKey: key, ImplementationStatus: status}
+	for _, t := range texts {
+		s.Narrative = append(s.Narrative, v3c.NarrativeSection{Text: t})
+	}
+	return s
+}
+
+func testData() parser.Data {
+	return parser.Data{
+		"AC-Access_Control": {
+			"AC-2":     buildControl("AC-2", parser.StatusComplete, "Accounts are reviewed", parser.PlaceholderEnhancement),
+			"AC-2 (1)": buildControl("AC-2 (1)", parser.StatusPlanned, parser.PlaceholderTextOnly),
+			"AC-3":     buildControl("AC-3", parser.StatusNotApplicable, "Not applicable"),
+		},
+		"AU-Audit_and_Accountability": {
+			"AU-2": buildControl("AU-2", "", "Events are logged"),
+		},
+	}
+}
+
+func TestNewCoverage(t *testing.T) {
+	got := NewCoverage(testData())
+
+	want := []FamilyCoverage{
+		{
+			Family:                "AC-Access_Control",
+			Controls:              2,
+			Enhancements:          1,
+			Narratives:            4,
+			Placeholders:          2,
+			NarrativeCompleteness: 50,
+			Statuses:              map[string]int{"complete": 1, "planned": 1, "not applicable": 1},
+			StatusPercent:         map[string]float64{"complete": 33.33, "planned": 33.33, "not applicable": 33.33},
+			Complete:              50,
+		},
+		{
+			Family:                "AU-Audit_and_Accountability",
+			Controls:              1,
+			Narratives:            1,
+			NarrativeCompleteness: 100,
+			Statuses:              map[string]int{StatusUnset: 1},
+			StatusPercent:         map[string]float64{StatusUnset: 100},
+		},
+	}
+	if !reflect.DeepEqual(got.Families, want) {
+		t.Errorf("NewCoverage() families = %+v, want %+v", got.Families, want)
+	}
+	if got.Total.Controls != 3 || got.Total.Enhancements != 1 || got.Total.Complete != 33.33 {
+		t.Errorf("NewCoverage() total = %+v", got.Total)
+	}
+}
+
+func TestCoverage_WriteMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewCoverage(testData()).WriteMarkdown(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("WriteMarkdown() wrote %d lines, want header, separator, 2 families and total:\n%s", len(lines), buf.String())
+	}
+	want := "| **Total** | 3 | 1 | 5 | 60.0% | 1 (25.0%) | 0 (0.0%) | 1 (25.0%) | 0 (0.0%) | 1 (25.0%) | 1 (25.0%) | 0 (0.0%) | 33.3% |"
+	if lines[4] != want {
+		t.Errorf("WriteMarkdown() total line = %q, want %q", lines[4], want)
+	}
+}
+
+func TestCoverage_OtherStatuses(t *testing.T) {
+	data := testData()
+	data["AU-Audit_and_Accountability"]["AU-3"] = buildControl("AU-3", "in review", "Records are reviewed")
+	c := NewCoverage(data)
+
+	var buf bytes.Buffer
+	if err := c.WriteMarkdown(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	want := "| AU-Audit_and_Accountability | 2 | 0 | 2 | 100.0% | 0 (0.0%) | 0 (0.0%) | 0 (0.0%) | 0 (0.0%) | 0 (0.0%) | 1 (50.0%) | 1 (50.0%) | 0.0% |"
+	if lines[3] != want {
+		t.Errorf("WriteMarkdown() family line = %q, want %q", lines[3], want)
+	}
+
+	buf.Reset()
+	if err := c.WriteTable(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if fields := strings.Fields(strings.Split(buf.String(), "\n")[3]); fields[len(fields)-2] != "1" {
+		t.Errorf("WriteTable() total line = %q, want 1 other", fields)
+	}
+}
@@ -0,0 +1,97 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/baseline"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// Gaps compares the controls in the assessment against a baseline.
+type Gaps struct {
+	Baseline  string `json:"baseline"`
+	Required  int    `json:"required"`
+	Addressed int    `json:"addressed"`
+	// Missing are required by the baseline but not in the assessment
+	Missing []string `json:"missing"`
+	// OverScoped are in the assessment but not required by the baseline
+	OverScoped []string `json:"over_scoped"`
+	// NoNarrative are in the assessment but only have placeholder narratives
+	NoNarrative []string `json:"no_narrative"`
+}
+
+// NewGaps computes the gaps between the parsed data and a baseline.
+func NewGaps(b baseline.Baseline, data parser.Data) Gaps {
+	g := Gaps{
+		Baseline:    b.Title,
+		Required:    len(b.Controls),
+		Missing:     []string{},
+		OverScoped:  []string{},
+		NoNarrative: []string{},
+	}
+
+	present := make(map[string]bool)
+	for _, ctrls := range data {
+		for key, ctrl := range ctrls {
+			present[key] = true
+			if b.Contains(key) {
+				g.Addressed++
+			} else {
+				g.OverScoped = append(g.OverScoped, key)
+			}
+			if !hasNarrative(ctrl.Narrative) {
+				g.NoNarrative = append(g.NoNarrative, key)
+			}
+		}
+	}
+	for _, key := range b.Controls {
+		if !present[key] {
+			g.Missing = append(g.Missing, key)
+		}
+	}
+
+	sortKeys(g.OverScoped)
+	sortKeys(g.NoNarrative)
+	return g
+}
+
+// WriteText writes the gaps as plain text.
+func (g Gaps) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "Baseline: %s\n", g.Baseline)
+	fmt.Fprintf(w, "Addressed: %d of %d required controls (%.1f%%)\n", g.Addressed, g.Required, percent(g.Addressed, g.Required))
+	if err := writeList(w, "Missing from the assessment", g.Missing); err != nil {
+		return err
+	}
+	if err := writeList(w, "Not required by the baseline", g.OverScoped); err != nil {
+		return err
+	}
+	return writeList(w, "Without narrative", g.NoNarrative)
+}
+
+// WriteJSON writes the gaps as JSON.
+func (g Gaps) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(g)
+}
+
+func writeList(w io.Writer, title string, keys []string) error {
+	fmt.Fprintf(w, "\n%s (%d)\n", title, len(keys))
+	for _, k := range keys {
+		if _, err := fmt.Fprintf(w, "  %s\n", k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortKeys(keys []string) {
+	sort.Slice(keys, func(i, j int) bool {
+		return sortorder.NaturalLess(keys[i], keys[j])
+	})
+}
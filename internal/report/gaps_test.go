@@ -0,0 +1,30 @@
+package report
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/carlosmmatos/automate-compliance/internal/baseline"
+)
+
+func TestNewGaps(t *testing.T) {
+	b, err := baseline.Get("nist-low")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewGaps(b, testData())
+
+	if got.Required != 149 || got.Addressed != 3 {
+		t.Errorf("NewGaps() addressed %d of %d, want 3 of 149", got.Addressed, got.Required)
+	}
+	if len(got.Missing) != 146 || got.Missing[0] != "AC-1" {
+		t.Errorf("NewGaps() missing = %v", got.Missing)
+	}
+	if want := []string{"AC-2 (1)"}; !reflect.DeepEqual(got.OverScoped, want) {
+		t.Errorf("NewGaps() over scoped = %v, want %v", got.OverScoped, want)
+	}
+	if want := []string{"AC-2 (1)"}; !reflect.DeepEqual(got.NoNarrative, want) {
+		t.Errorf("NewGaps() without narrative = %v, want %v", got.NoNarrative, want)
+	}
+}
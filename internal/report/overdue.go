@@ -0,0 +1,132 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// Grace is the number of days a control may stay open past its date before
+// it is overdue.
+type Grace struct {
+	Days int `json:"days"`
+	// ByStatus overrides Days for some statuses, StatusUnset being the
+	// controls without status
+	ByStatus map[string]int `json:"by_status,omitempty"`
+}
+
+// For returns the grace period of a status.
+func (g Grace) For(status string) int {
+	if status == "" {
+		status = StatusUnset
+	}
+	if days, ok := g.ByStatus[status]; ok {
+		return days
+	}
+	return g.Days
+}
+
+// OverdueControl is a control that isn't complete past its date.
+type OverdueControl struct {
+	ControlKey string `json:"control_key"`
+	Owner      string `json:"owner,omitempty"`
+	Status     string `json:"status"`
+	// Date is the due date of the control, or its milestone when it has no
+	// due date
+	Date     string `json:"date"`
+	DaysLate int    `json:"days_late"`
+}
+
+// Overdue lists the controls that aren't complete past their due date.
+type Overdue struct {
+	// Date is the day the controls were checked on
+	Date     string           `json:"date"`
+	Grace    Grace            `json:"grace"`
+	Controls []OverdueControl `json:"controls"`
+	// Undated is the number of open controls with neither due date nor
+	// milestone
+	Undated int `json:"undated"`
+}
+
+// NewOverdue returns the controls of the parsed data whose status isn't
+// complete or not applicable more than their grace period after their due
+// date, or milestone when they have no due date, most late first. The dates
+// are days in the location of now.
+func NewOverdue(data parser.Data, details map[string]parser.Details, now time.Time, grace Grace) Overdue {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	o := Overdue{Date: today.Format(parser.DateFormat), Grace: grace, Controls: []OverdueControl{}}
+	for _, ctrls := range data {
+		for key, ctrl := range ctrls {
+			status := ctrl.ImplementationStatus
+			if status == parser.StatusComplete || status == parser.StatusNotApplicable {
+				continue
+			}
+			d := details[key]
+			date := d.Due
+			if date == "" {
+				date = d.Milestone
+			}
+			if date == "" {
+				o.Undated++
+				continue
+			}
+			t, err := time.ParseInLocation(parser.DateFormat, date, now.Location())
+			if err != nil {
+				continue
+			}
+			// days aren't 24 hours long when the clocks change
+			late := int(math.Round(today.Sub(t).Hours() / 24))
+			if late <= grace.For(status) {
+				continue
+			}
+			o.Controls = append(o.Controls, OverdueControl{
+				ControlKey: key,
+				Owner:      d.Owner,
+				Status:     status,
+				Date:       date,
+				DaysLate:   late,
+			})
+		}
+	}
+	sort.Slice(o.Controls, func(i, j int) bool {
+		a, b := o.Controls[i], o.Controls[j]
+		if a.DaysLate != b.DaysLate {
+			return a.DaysLate > b.DaysLate
+		}
+		return sortorder.NaturalLess(a.ControlKey, b.ControlKey)
+	})
+	return o
+}
+
+// WriteText writes a line per overdue control.
+func (o Overdue) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "Overdue on %s: %d controls\n", o.Date, len(o.Controls))
+	if o.Undated > 0 {
+		fmt.Fprintf(w, "Open controls without due date or milestone: %d\n", o.Undated)
+	}
+	if len(o.Controls) == 0 {
+		return nil
+	}
+	fmt.Fprintln(w)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CONTROL\tSTATUS\tOWNER\tDUE\tDAYS LATE")
+	for _, c := range o.Controls {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\n", c.ControlKey, orDash(c.Status), orDash(c.Owner), c.Date, c.DaysLate)
+	}
+	return tw.Flush()
+}
+
+// WriteJSON writes the overdue controls as JSON.
+func (o Overdue) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(o)
+}
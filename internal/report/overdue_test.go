@@ -0,0 +1,59 @@
+package report
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+func TestNewOverdue(t *testing.T) {
+	data := parser.Data{
+		"AC-Access_Control": {
+			"AC-2":     buildControl("AC-2", parser.StatusComplete),
+			"AC-2 (1)": buildControl("AC-2 (1)", parser.StatusPlanned),
+			"AC-3":     buildControl("AC-3", parser.StatusPartial),
+			"AC-4":     buildControl("AC-4", ""),
+			"AC-5":     buildControl("AC-5", parser.StatusPlanned),
+			"AC-6":     buildControl("AC-6", parser.StatusNone),
+		},
+	}
+	details := map[string]parser.Details{
+		"AC-2":     {Due: "2026-01-01"},
+		"AC-2 (1)": {Owner: "alice", Due: "2026-09-01", Milestone: "2026-12-01"},
+		"AC-3":     {Due: "2026-09-20"},
+		"AC-4":     {Milestone: "2026-09-25"},
+		"AC-6":     {Due: "2026-10-15"},
+	}
+	now := time.Date(2026, 10, 1, 15, 0, 0, 0, time.UTC)
+	grace := Grace{Days: 3, ByStatus: map[string]int{parser.StatusPartial: 30}}
+
+	got := NewOverdue(data, details, now, grace)
+	want := Overdue{
+		Date:  "2026-10-01",
+		Grace: grace,
+		Controls: []OverdueControl{
+			{ControlKey: "AC-2 (1)", Owner: "alice", Status: parser.StatusPlanned, Date: "2026-09-01", DaysLate: 30},
+			{ControlKey: "AC-4", Date: "2026-09-25", DaysLate: 6},
+		},
+		Undated: 1,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewOverdue() = %+v, want %+v", got, want)
+	}
+}
+
+func TestNewOverdue_Location(t *testing.T) {
+	data := parser.Data{
+		"AC-Access_Control": {"AC-2": buildControl("AC-2", parser.StatusPlanned)},
+	}
+	details := map[string]parser.Details{"AC-2": {Due: "2026-09-30"}}
+	// still October 1st in Denver while it's already October 2nd in UTC
+	now := time.Date(2026, 10, 1, 20, 0, 0, 0, time.FixedZone("MDT", -6*60*60))
+
+	got := NewOverdue(data, details, now, Grace{})
+	if got.Date != "2026-10-01" || len(got.Controls) != 1 || got.Controls[0].DaysLate != 1 {
+		t.Errorf("NewOverdue() = %+v, want AC-2 1 day late on 2026-10-01", got)
+	}
+}
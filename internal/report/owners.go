@@ -0,0 +1,155 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// Unassigned is the owner name used for the controls without owner.
+const Unassigned = "(unassigned)"
+
+// OpenItem is a control that isn't complete yet.
+type OpenItem struct {
+	ControlKey string `json:"control_key"`
+	Status     string `json:"status"`
+	Milestone  string `json:"milestone,omitempty"`
+}
+
+// StaleNarrative is a control whose narratives haven't changed for longer
+// than allowed.
+type StaleNarrative struct {
+	ControlKey string `json:"control_key"`
+	// Since is the date of the first run since which the narratives haven't
+	// changed
+	Since string `json:"since"`
+	Days  int    `json:"days"`
+}
+
+// OwnerAccountability is what an owner is accountable for.
+type OwnerAccountability struct {
+	Owner    string   `json:"owner"`
+	Controls []string `json:"controls"`
+	// Complete is the percentage of applicable controls that are complete
+	Complete float64          `json:"complete"`
+	Open     []OpenItem       `json:"open"`
+	Stale    []StaleNarrative `json:"stale"`
+}
+
+// Owners groups the controls of the assessment by owner.
+type Owners struct {
+	// StaleAfter is the number of days after which unchanged narratives are
+	// stale
+	StaleAfter int                   `json:"stale_after_days"`
+	Owners     []OwnerAccountability `json:"owners"`
+}
+
+// NewOwners groups the controls of the parsed data by the owner of their
+// details. changed gives the time since which the narratives of the controls
+// haven't changed, see history.NarrativeChanged; narratives unchanged for
+// more than staleAfter days on now are stale.
+func NewOwners(data parser.Data, details map[string]parser.Details, changed map[string]time.Time, now time.Time, staleAfter int) Owners {
+	byOwner := make(map[string]*OwnerAccountability)
+	applicable := make(map[string]int)
+	complete := make(map[string]int)
+	for _, ctrls := range data {
+		for key, ctrl := range ctrls {
+			owner := details[key].Owner
+			if owner == "" {
+				owner = Unassigned
+			}
+			oa, ok := byOwner[owner]
+			if !ok {
+				oa = &OwnerAccountability{Owner: owner, Controls: []string{}, Open: []OpenItem{}, Stale: []StaleNarrative{}}
+				byOwner[owner] = oa
+			}
+			oa.Controls = append(oa.Controls, key)
+
+			switch ctrl.ImplementationStatus {
+			case parser.StatusNotApplicable:
+			case parser.StatusComplete:
+				applicable[owner]++
+				complete[owner]++
+			default:
+				applicable[owner]++
+				oa.Open = append(oa.Open, OpenItem{ControlKey: key, Status: ctrl.ImplementationStatus, Milestone: details[key].Milestone})
+			}
+
+			if since, ok := changed[key]; ok {
+				if days := int(now.Sub(since).Hours() / 24); days > staleAfter {
+					oa.Stale = append(oa.Stale, StaleNarrative{ControlKey: key, Since: since.Format(parser.DateFormat), Days: days})
+				}
+			}
+		}
+	}
+
+	o := Owners{StaleAfter: staleAfter, Owners: []OwnerAccountability{}}
+	for owner, oa := range byOwner {
+		oa.Complete = percent(complete[owner], applicable[owner])
+		sortKeys(oa.Controls)
+		sort.Slice(oa.Open, func(i, j int) bool {
+			return sortorder.NaturalLess(oa.Open[i].ControlKey, oa.Open[j].ControlKey)
+		})
+		sort.Slice(oa.Stale, func(i, j int) bool {
+			return sortorder.NaturalLess(oa.Stale[i].ControlKey, oa.Stale[j].ControlKey)
+		})
+		o.Owners = append(o.Owners, *oa)
+	}
+	// the unassigned controls go last
+	sort.Slice(o.Owners, func(i, j int) bool {
+		a, b := o.Owners[i].Owner, o.Owners[j].Owner
+		if (a == Unassigned) != (b == Unassigned) {
+			return b == Unassigned
+		}
+		return a < b
+	})
+	return o
+}
+
+// WriteMarkdown writes a summary table of the owners followed by a section
+// per owner.
+func (o Owners) WriteMarkdown(w io.Writer) error {
+	fmt.Fprintf(w, "# Owner accountability\n\n")
+	fmt.Fprintf(w, "| Owner | Controls | Complete | Open | Stale |\n|---|--:|--:|--:|--:|\n")
+	for _, oa := range o.Owners {
+		fmt.Fprintf(w, "| %s | %d | %.1f%% | %d | %d |\n", oa.Owner, len(oa.Controls), oa.Complete, len(oa.Open), len(oa.Stale))
+	}
+	for _, oa := range o.Owners {
+		fmt.Fprintf(w, "\n## %s\n\n", oa.Owner)
+		fmt.Fprintf(w, "%d controls, %.1f%% complete: %s\n", len(oa.Controls), oa.Complete, strings.Join(oa.Controls, ", "))
+		if len(oa.Open) > 0 {
+			fmt.Fprintf(w, "\n### Open items\n\n| Control | Status | Milestone |\n|---|---|---|\n")
+			for _, item := range oa.Open {
+				fmt.Fprintf(w, "| %s | %s | %s |\n", item.ControlKey, orDash(item.Status), orDash(item.Milestone))
+			}
+		}
+		if len(oa.Stale) > 0 {
+			fmt.Fprintf(w, "\n### Stale narratives\n\nUnchanged for more than %d days:\n\n", o.StaleAfter)
+			for _, s := range oa.Stale {
+				fmt.Fprintf(w, "* %s, since %s (%d days)\n", s.ControlKey, s.Since, s.Days)
+			}
+		}
+	}
+	return nil
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// WriteJSON writes the owners as JSON.
+func (o Owners) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(o)
+}
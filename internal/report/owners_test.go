@@ -0,0 +1,50 @@
+package report
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+func TestNewOwners(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	details := map[string]parser.Details{
+		"AC-2":     {Owner: "alice"},
+		"AC-2 (1)": {Owner: "alice", Milestone: "2026-12-31"},
+		"AC-3":     {Owner: "bob"},
+	}
+	changed := map[string]time.Time{
+		"AC-2":     now.AddDate(0, 0, -200),
+		"AC-2 (1)": now.AddDate(0, 0, -10),
+	}
+
+	got := NewOwners(testData(), details, changed, now, 180)
+	want := Owners{StaleAfter: 180, Owners: []OwnerAccountability{
+		{
+			Owner:    "alice",
+			Controls: []string{"AC-2", "AC-2 (1)"},
+			Complete: 50,
+			Open:     []OpenItem{{ControlKey: "AC-2 (1)", Status: parser.StatusPlanned, Milestone: "2026-12-31"}},
+			Stale:    []StaleNarrative{{ControlKey: "AC-2", Since: "2026-03-15", Days: 200}},
+		},
+		{Owner: "bob", Controls: []string{"AC-3"}, Open: []OpenItem{}, Stale: []StaleNarrative{}},
+		{Owner: Unassigned, Controls: []string{"AU-2"}, Open: []OpenItem{{ControlKey: "AU-2"}}, Stale: []StaleNarrative{}},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewOwners() = %+v, want %+v", got, want)
+	}
+
+	var buf bytes.Buffer
+	if err := got.WriteMarkdown(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"| alice | 2 | 50.0% | 1 | 1 |", "| AC-2 (1) | planned | 2026-12-31 |", "* AC-2, since 2026-03-15 (200 days)", "## (unassigned)"} {
+		if !strings.Contains(buf.String(), s) {
+			t.Errorf("Owners.WriteMarkdown() doesn't contain %q:\n%s", s, buf.String())
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/carlosmmatos/automate-compliance/internal/catalog"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// ControlParts compares the narrative keys of a control with the parts of
+// its statement in the catalog. The narrative of the control as a whole has
+// the empty key.
+type ControlParts struct {
+	ControlKey string `json:"control_key"`
+	// Missing are the parts no narrative answers
+	Missing []string `json:"missing"`
+	// Extra are the narrative keys the catalog doesn't have
+	Extra []string `json:"extra"`
+	// Coarse are the narratives answering a part that has sub-parts
+	Coarse []string `json:"coarse"`
+	// Fine are the narratives answering below the parts of the catalog,
+	// e.g. "a.1" when part a has no items
+	Fine []string `json:"fine"`
+}
+
+func (c ControlParts) ok() bool {
+	return len(c.Missing)+len(c.Extra)+len(c.Coarse)+len(c.Fine) == 0
+}
+
+// Parts compares the narratives of the assessment with the statement parts
+// of the controls.
+type Parts struct {
+	Catalog string `json:"catalog"`
+	// Controls is the number of controls of the assessment in the catalog
+	Controls int `json:"controls"`
+	// Flagged are the controls whose narratives don't match their parts
+	Flagged []ControlParts `json:"flagged"`
+	// Unknown are the controls of the assessment the catalog doesn't have
+	Unknown []string `json:"unknown"`
+}
+
+// NewParts compares the narratives of every control of the parsed data with
+// the parts of the catalog.
+func NewParts(data parser.Data, c *catalog.Catalog) Parts {
+	p := Parts{Catalog: c.Title, Flagged: []ControlParts{}, Unknown: []string{}}
+	var keys []string
+	ctrls := make(map[string][]string)
+	for _, family := range data {
+		for key, ctrl := range family {
+			keys = append(keys, key)
+			for _, n := range ctrl.Narrative {
+				ctrls[key] = append(ctrls[key], n.Key)
+			}
+		}
+	}
+	sortKeys(keys)
+	for _, key := range keys {
+		parts, ok := c.ControlParts(key)
+		if !ok {
+			p.Unknown = append(p.Unknown, key)
+			continue
+		}
+		p.Controls++
+		if cp := compareParts(key, parts, ctrls[key]); !cp.ok() {
+			p.Flagged = append(p.Flagged, cp)
+		}
+	}
+	return p
+}
+
+// compareParts compares the narrative keys of a control with the parts of
+// the catalog. A narrative answers its part along with the sub-parts, so a
+// part is covered when it, one of its ancestors or all of its sub-parts are
+// answered.
+func compareParts(controlKey string, parts, answered []string) ControlParts {
+	cp := ControlParts{ControlKey: controlKey, Missing: []string{}, Extra: []string{}, Coarse: []string{}, Fine: []string{}}
+	known := map[string]bool{"": true}
+	children := make(map[string][]string)
+	for _, part := range parts {
+		known[part] = true
+		children[catalog.Parent(part)] = append(children[catalog.Parent(part)], part)
+	}
+
+	covered := make(map[string]bool)
+	for _, key := range answered {
+		switch {
+		case known[key]:
+			if len(children[key]) > 0 && !contains(cp.Coarse, key) {
+				cp.Coarse = append(cp.Coarse, key)
+			}
+			covered[key] = true
+		case known[catalog.Parent(key)] && len(children[catalog.Parent(key)]) == 0:
+			if !contains(cp.Fine, key) {
+				cp.Fine = append(cp.Fine, key)
+			}
+			covered[catalog.Parent(key)] = true
+		default:
+			if !contains(cp.Extra, key) {
+				cp.Extra = append(cp.Extra, key)
+			}
+		}
+	}
+
+	// isCovered tells whether a part is answered by itself or its sub-parts
+	var isCovered func(part string) bool
+	isCovered = func(part string) bool {
+		if covered[part] {
+			return true
+		}
+		if len(children[part]) == 0 {
+			return false
+		}
+		for _, child := range children[part] {
+			if !isCovered(child) {
+				return false
+			}
+		}
+		return true
+	}
+	// missing reports the largest parts nothing answers, under a part that
+	// isn't answered by an ancestor
+	var missing func(part string)
+	missing = func(part string) {
+		if covered[part] {
+			return
+		}
+		for _, child := range children[part] {
+			if isCovered(child) {
+				continue
+			}
+			if anyCovered(child, covered, children) {
+				missing(child)
+			} else {
+				cp.Missing = append(cp.Missing, child)
+			}
+		}
+	}
+	missing("")
+
+	sortKeys(cp.Missing)
+	sortKeys(cp.Extra)
+	sortKeys(cp.Coarse)
+	sortKeys(cp.Fine)
+	return cp
+}
+
+// anyCovered tells whether a part or one of its sub-parts is answered.
+func anyCovered(part string, covered map[string]bool, children map[string][]string) bool {
+	if covered[part] {
+		return true
+	}
+	for _, child := range children[part] {
+		if anyCovered(child, covered, children) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteText writes a line per flagged control, followed by the controls the
+// catalog doesn't have.
+func (p Parts) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "Catalog: %s\n", p.Catalog)
+	fmt.Fprintf(w, "Controls matching their parts: %d of %d (%.1f%%)\n\n", p.Controls-len(p.Flagged), p.Controls, percent(p.Controls-len(p.Flagged), p.Controls))
+	for _, cp := range p.Flagged {
+		var problems []string
+		for _, l := range []struct {
+			title string
+			keys  []string
+		}{
+			{"missing", cp.Missing},
+			{"extra", cp.Extra},
+			{"answered as a whole", cp.Coarse},
+			{"finer than the catalog", cp.Fine},
+		} {
+			if len(l.keys) > 0 {
+				problems = append(problems, l.title+" "+joinParts(l.keys))
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%s: %s\n", cp.ControlKey, strings.Join(problems, "; ")); err != nil {
+			return err
+		}
+	}
+	return writeList(w, "Not in the catalog", p.Unknown)
+}
+
+// joinParts joins narrative keys, naming the empty key after the control.
+func joinParts(keys []string) string {
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		if k == "" {
+			k = "(control)"
+		}
+		names[i] = k
+	}
+	return strings.Join(names, ", ")
+}
+
+// WriteJSON writes the comparison as JSON.
+func (p Parts) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(p)
+}
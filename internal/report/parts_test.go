@@ -0,0 +1,41 @@
+package report
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/carlosmmatos/automate-compliance/internal/catalog"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+func TestNewParts(t *testing.T) {
+	p := parser.NewParser()
+	controls := []string{
+		"AC-2", "AC-2a.", "AC-2b.", "AC-2d.1.", "AC-2m.",
+		"AC-3", "AC-3b.",
+		"AC-7",
+		"AC-5a.", "AC-5b.",
+		"AC-2 (3)", "AC-2 (3)(a)", "AC-2 (3)(b)", "AC-2 (3)(c)", "AC-2 (3)(d)",
+		"ZZ-1",
+	}
+	for _, c := range controls {
+		if err := p.ParseEntry("ACCESS CONTROL", c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got := NewParts(p.GetData(), catalog.Default())
+	want := Parts{
+		Catalog:  catalog.Default().Title,
+		Controls: 5,
+		Flagged: []ControlParts{
+			{"AC-2", []string{"c", "d.2", "d.3", "e", "f", "g", "h", "i", "j", "k", "l"}, []string{"m"}, []string{}, []string{}},
+			{"AC-3", []string{}, []string{}, []string{}, []string{"b"}},
+			{"AC-7", []string{}, []string{}, []string{""}, []string{}},
+		},
+		Unknown: []string{"ZZ-1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewParts() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,77 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+	"github.com/carlosmmatos/automate-compliance/internal/rhacm"
+)
+
+// Policies maps the controls of the assessment to the RHACM policies
+// enforcing them.
+type Policies struct {
+	Controls int                     `json:"controls"`
+	Enforced []rhacm.ControlPolicies `json:"enforced"`
+	// Unenforced are the controls of the assessment without policy
+	Unenforced []string `json:"unenforced"`
+	// NotInSheet are the controls of policies the assessment doesn't have
+	NotInSheet []string `json:"not_in_sheet"`
+}
+
+// NewPolicies computes the policies of every control of the parsed data.
+func NewPolicies(data parser.Data, set *rhacm.Set) Policies {
+	p := Policies{Enforced: []rhacm.ControlPolicies{}, Unenforced: []string{}, NotInSheet: []string{}}
+	enforced := make(map[string]bool)
+	present := make(map[string]bool)
+	for _, ctrls := range data {
+		for key := range ctrls {
+			present[key] = true
+			p.Controls++
+		}
+	}
+	for _, cp := range set.ByControl() {
+		enforced[cp.ControlKey] = true
+		if present[cp.ControlKey] {
+			p.Enforced = append(p.Enforced, cp)
+		} else {
+			p.NotInSheet = append(p.NotInSheet, cp.ControlKey)
+		}
+	}
+	for key := range present {
+		if !enforced[key] {
+			p.Unenforced = append(p.Unenforced, key)
+		}
+	}
+	sortKeys(p.Unenforced)
+	return p
+}
+
+// WriteText writes a line per control and policy, followed by the controls
+// without policy.
+func (p Policies) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "Enforced by policies: %d of %d controls (%.1f%%)\n\n", len(p.Enforced), p.Controls, percent(len(p.Enforced), p.Controls))
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CONTROL\tPOLICY\tSTATUS")
+	for _, cp := range p.Enforced {
+		for _, policy := range cp.Policies {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", cp.ControlKey, policy.Name, policy.Summary())
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if err := writeList(w, "Without policy", p.Unenforced); err != nil {
+		return err
+	}
+	return writeList(w, "Enforced by policies but not in the assessment", p.NotInSheet)
+}
+
+// WriteJSON writes the policies as JSON.
+func (p Policies) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(p)
+}
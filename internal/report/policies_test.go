@@ -0,0 +1,34 @@
+package report
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/carlosmmatos/automate-compliance/internal/rhacm"
+)
+
+func TestNewPolicies(t *testing.T) {
+	set := rhacm.NewSet()
+	doc := `kind: Policy
+metadata:
+  name: policy-accounts
+  annotations:
+    policy.open-cluster-management.io/standards: NIST SP 800-53
+    policy.open-cluster-management.io/controls: AC-2 Account Management, AC-6 Least Privilege
+`
+	if err := set.Parse(strings.NewReader(doc), "policy.yaml"); err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewPolicies(testData(), set)
+	if len(got.Enforced) != 1 || got.Enforced[0].ControlKey != "AC-2" || got.Enforced[0].Policies[0].Name != "policy-accounts" {
+		t.Errorf("NewPolicies() enforced = %v", got.Enforced)
+	}
+	if want := []string{"AC-2 (1)", "AC-3", "AU-2"}; !reflect.DeepEqual(got.Unenforced, want) {
+		t.Errorf("NewPolicies() unenforced = %v, want %v", got.Unenforced, want)
+	}
+	if want := []string{"AC-6"}; !reflect.DeepEqual(got.NotInSheet, want) {
+		t.Errorf("NewPolicies() not in sheet = %v, want %v", got.NotInSheet, want)
+	}
+}
@@ -0,0 +1,427 @@
+package rhacm
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// API versions of the generated objects.
+const (
+	GeneratorAPIVersion = "policy.open-cluster-management.io/v1"
+	PolicyAPIVersion    = "policy.open-cluster-management.io/v1"
+	PlacementAPIVersion = "cluster.open-cluster-management.io/v1beta1"
+	// StandardNIST is the standard annotation of the generated policies
+	StandardNIST = "NIST SP 800-53"
+)
+
+// Files written by Generator.Write.
+const (
+	GeneratorFile     = "policy-generator.yaml"
+	KustomizationFile = "kustomization.yaml"
+	PoliciesDir       = "policies"
+	// TemplatesDir holds the copies of the policy templates that aren't
+	// under the output directory, which kustomize refuses to read
+	TemplatesDir = "templates"
+	// PlacementFile holds the placement of the policies of PoliciesDir and
+	// its binding
+	PlacementFile = "placement.yaml"
+)
+
+// Generator is a PolicyGenerator configuration, turned into policies by
+// kustomize with the PolicyGenerator plugin.
+type Generator struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	PlacementBindingDefaults struct {
+		Name string `yaml:"name"`
+	} `yaml:"placementBindingDefaults"`
+	PolicyDefaults GeneratorPolicy   `yaml:"policyDefaults"`
+	Policies       []GeneratorPolicy `yaml:"policies"`
+}
+
+// GeneratorPolicy is a policy of a PolicyGenerator, or the defaults of its
+// policies.
+type GeneratorPolicy struct {
+	Name              string     `yaml:"name,omitempty"`
+	Namespace         string     `yaml:"namespace,omitempty"`
+	Standards         []string   `yaml:"standards,omitempty"`
+	Categories        []string   `yaml:"categories,omitempty"`
+	Controls          []string   `yaml:"controls,omitempty"`
+	RemediationAction string     `yaml:"remediationAction,omitempty"`
+	Severity          string     `yaml:"severity,omitempty"`
+	Disabled          bool       `yaml:"disabled,omitempty"`
+	Placement         *Placement `yaml:"placement,omitempty"`
+	Manifests         []Manifest `yaml:"manifests,omitempty"`
+}
+
+// Placement selects the clusters the policies apply to.
+type Placement struct {
+	ClusterSelectors map[string]string `yaml:"clusterSelectors"`
+}
+
+// Manifest is a policy template, a file relative to the generator.
+type Manifest struct {
+	Path string `yaml:"path"`
+}
+
+// NewGenerator returns a PolicyGenerator configuration with a policy for
+// every control of the parsed data that is marked automatable and has
+// policy templates. The defaults are completed with the NIST standard. It
+// also returns the automatable controls skipped for lack of template.
+func NewGenerator(name string, defaults GeneratorPolicy, data parser.Data, details map[string]parser.Details) (*Generator, []string) {
+	g := &Generator{APIVersion: GeneratorAPIVersion, Kind: "PolicyGenerator"}
+	g.Metadata.Name = name
+	g.PlacementBindingDefaults.Name = name + "-placement-binding"
+	g.PolicyDefaults = defaults
+	g.PolicyDefaults.Standards = []string{StandardNIST}
+
+	var skipped []string
+	for family, ctrls := range data {
+		for key := range ctrls {
+			d := details[key]
+			if !d.Automatable {
+				continue
+			}
+			if len(d.Policies) == 0 {
+				skipped = append(skipped, key)
+				continue
+			}
+			gp := GeneratorPolicy{
+				Name:       PolicyName(key),
+				Categories: []string{Category(family)},
+				Controls:   []string{key},
+			}
+			for _, path := range d.Policies {
+				gp.Manifests = append(gp.Manifests, Manifest{Path: path})
+			}
+			g.Policies = append(g.Policies, gp)
+		}
+	}
+
+	sort.Slice(g.Policies, func(i, j int) bool {
+		return sortorder.NaturalLess(g.Policies[i].Controls[0], g.Policies[j].Controls[0])
+	})
+	sort.Slice(skipped, func(i, j int) bool {
+		return sortorder.NaturalLess(skipped[i], skipped[j])
+	})
+	return g, skipped
+}
+
+var nonNameRe = regexp.MustCompile(`[^a-z0-9]+`)
+
+// PolicyName returns the name of the policy of a control, e.g.
+// "policy-ac-2-1" for AC-2 (1).
+func PolicyName(controlKey string) string {
+	return "policy-" + strings.Trim(nonNameRe.ReplaceAllString(strings.ToLower(controlKey), "-"), "-")
+}
+
+// Category returns the category annotation of a family, e.g.
+// "AC Access Control" for AC-Access_Control.
+func Category(family parser.ControlFamily) string {
+	return strings.Replace(strings.Replace(string(family), "-", " ", 1), "_", " ", -1)
+}
+
+// Write writes the generator configuration and its kustomization into dir,
+// along with the policies it generates, their placement and its binding in
+// the policies directory, for applying them without kustomize. The paths of
+// the templates are relative to templateDir. The templates that aren't under
+// dir are copied to its templates directory, since kustomize only reads the
+// files of the kustomization directory.
+func (g *Generator) Write(dir, templateDir string) error {
+	if err := os.MkdirAll(filepath.Join(dir, PoliciesDir), 0755); err != nil {
+		return err
+	}
+
+	out := *g
+	out.Policies = nil
+	for _, gp := range g.Policies {
+		var manifests [][]byte
+		rewritten := gp
+		rewritten.Manifests = nil
+		for _, m := range gp.Manifests {
+			src := m.Path
+			if !filepath.IsAbs(src) {
+				src = filepath.Join(templateDir, src)
+			}
+			b, err := ioutil.ReadFile(src)
+			if err != nil {
+				return fmt.Errorf("policy template of %s: %v", gp.Controls[0], err)
+			}
+			manifests = append(manifests, b)
+
+			path, err := inTree(dir, templateDir, src, b)
+			if err != nil {
+				return fmt.Errorf("policy template of %s: %v", gp.Controls[0], err)
+			}
+			rewritten.Manifests = append(rewritten.Manifests, Manifest{Path: path})
+		}
+		out.Policies = append(out.Policies, rewritten)
+
+		f, err := os.Create(filepath.Join(dir, PoliciesDir, gp.Name+".yaml"))
+		if err != nil {
+			return err
+		}
+		if err := g.writePolicy(f, gp, manifests); err != nil {
+			f.Close()
+			return fmt.Errorf("policy of %s: %v", gp.Controls[0], err)
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.Create(filepath.Join(dir, PoliciesDir, PlacementFile))
+	if err != nil {
+		return err
+	}
+	if err := g.writePlacement(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if err := writeYAML(filepath.Join(dir, GeneratorFile), out); err != nil {
+		return err
+	}
+	kustomization := map[string][]string{"generators": {"./" + GeneratorFile}}
+	return writeYAML(filepath.Join(dir, KustomizationFile), kustomization)
+}
+
+// inTree returns the path of a template relative to dir, copying it to the
+// templates directory of dir first when it isn't under dir.
+func inTree(dir, templateDir, src string, content []byte) (string, error) {
+	if rel, err := filepath.Rel(dir, src); err == nil && !isOutside(rel) {
+		return filepath.ToSlash(rel), nil
+	}
+	rel, err := filepath.Rel(templateDir, src)
+	if err != nil || isOutside(rel) {
+		return "", fmt.Errorf("%s is neither under %s nor under the templates directory %s", src, dir, templateDir)
+	}
+	rel = filepath.Join(TemplatesDir, rel)
+	dst := filepath.Join(dir, rel)
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(dst, content, 0644); err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+func isOutside(rel string) bool {
+	return rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func writeYAML(path string, v interface{}) error {
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+type policyManifest struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name        string            `yaml:"name"`
+		Namespace   string            `yaml:"namespace,omitempty"`
+		Annotations map[string]string `yaml:"annotations"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Disabled          bool             `yaml:"disabled"`
+		RemediationAction string           `yaml:"remediationAction"`
+		PolicyTemplates   []policyTemplate `yaml:"policy-templates"`
+	} `yaml:"spec"`
+}
+
+type placementManifest struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name      string `yaml:"name"`
+		Namespace string `yaml:"namespace,omitempty"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Predicates []placementPredicate `yaml:"predicates,omitempty"`
+	} `yaml:"spec"`
+}
+
+type placementPredicate struct {
+	RequiredClusterSelector struct {
+		LabelSelector struct {
+			MatchLabels map[string]string `yaml:"matchLabels"`
+		} `yaml:"labelSelector"`
+	} `yaml:"requiredClusterSelector"`
+}
+
+type placementBindingManifest struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name      string `yaml:"name"`
+		Namespace string `yaml:"namespace,omitempty"`
+	} `yaml:"metadata"`
+	PlacementRef bindingRef   `yaml:"placementRef"`
+	Subjects     []bindingRef `yaml:"subjects"`
+}
+
+type bindingRef struct {
+	APIGroup string `yaml:"apiGroup"`
+	Kind     string `yaml:"kind"`
+	Name     string `yaml:"name"`
+}
+
+// writePlacement writes the Placement selecting the clusters of the default
+// placement of the policies, every cluster of the cluster sets bound to the
+// namespace without cluster selector, and the PlacementBinding binding the
+// policies to it.
+func (g *Generator) writePlacement(w io.Writer) error {
+	d := g.PolicyDefaults
+	p := placementManifest{APIVersion: PlacementAPIVersion, Kind: "Placement"}
+	p.Metadata.Name = g.Metadata.Name + "-placement"
+	p.Metadata.Namespace = d.Namespace
+	if d.Placement != nil && len(d.Placement.ClusterSelectors) > 0 {
+		var pred placementPredicate
+		pred.RequiredClusterSelector.LabelSelector.MatchLabels = d.Placement.ClusterSelectors
+		p.Spec.Predicates = []placementPredicate{pred}
+	}
+
+	b := placementBindingManifest{APIVersion: PolicyAPIVersion, Kind: "PlacementBinding"}
+	b.Metadata.Name = g.PlacementBindingDefaults.Name
+	b.Metadata.Namespace = d.Namespace
+	b.PlacementRef = bindingRef{APIGroup: "cluster.open-cluster-management.io", Kind: "Placement", Name: p.Metadata.Name}
+	for _, gp := range g.Policies {
+		b.Subjects = append(b.Subjects, bindingRef{APIGroup: "policy.open-cluster-management.io", Kind: "Policy", Name: gp.Name})
+	}
+
+	enc := yaml.NewEncoder(w)
+	if err := enc.Encode(p); err != nil {
+		return err
+	}
+	if err := enc.Encode(b); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+type policyTemplate struct {
+	ObjectDefinition interface{} `yaml:"objectDefinition"`
+}
+
+type configurationPolicy struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	Spec struct {
+		RemediationAction string           `yaml:"remediationAction"`
+		Severity          string           `yaml:"severity"`
+		ObjectTemplates   []objectTemplate `yaml:"object-templates"`
+	} `yaml:"spec"`
+}
+
+type objectTemplate struct {
+	ComplianceType   string        `yaml:"complianceType"`
+	ObjectDefinition yaml.MapSlice `yaml:"objectDefinition"`
+}
+
+// writePolicy writes the policy generated out of the manifests of a policy,
+// the way the PolicyGenerator does: policy templates, such as a
+// ConfigurationPolicy, are used as is, and the other objects are wrapped
+// into a ConfigurationPolicy checking that they exist.
+func (g *Generator) writePolicy(w io.Writer, gp GeneratorPolicy, manifests [][]byte) error {
+	d := g.PolicyDefaults
+	p := policyManifest{APIVersion: PolicyAPIVersion, Kind: "Policy"}
+	p.Metadata.Name = gp.Name
+	p.Metadata.Namespace = firstNonEmpty(gp.Namespace, d.Namespace)
+	p.Metadata.Annotations = map[string]string{
+		AnnotationStandards:  strings.Join(d.Standards, ", "),
+		AnnotationCategories: strings.Join(gp.Categories, ", "),
+		AnnotationControls:   strings.Join(gp.Controls, ", "),
+	}
+	p.Spec.Disabled = gp.Disabled || d.Disabled
+	p.Spec.RemediationAction = firstNonEmpty(gp.RemediationAction, d.RemediationAction, "inform")
+
+	cp := configurationPolicy{APIVersion: PolicyAPIVersion, Kind: "ConfigurationPolicy"}
+	cp.Metadata.Name = gp.Name
+	cp.Spec.RemediationAction = p.Spec.RemediationAction
+	cp.Spec.Severity = firstNonEmpty(gp.Severity, d.Severity, "low")
+	for _, m := range manifests {
+		objects, err := decodeObjects(m)
+		if err != nil {
+			return err
+		}
+		for _, o := range objects {
+			if isPolicyTemplate(o) {
+				p.Spec.PolicyTemplates = append(p.Spec.PolicyTemplates, policyTemplate{o})
+			} else {
+				cp.Spec.ObjectTemplates = append(cp.Spec.ObjectTemplates, objectTemplate{"musthave", o})
+			}
+		}
+	}
+	if len(cp.Spec.ObjectTemplates) > 0 {
+		p.Spec.PolicyTemplates = append(p.Spec.PolicyTemplates, policyTemplate{cp})
+	}
+	if len(p.Spec.PolicyTemplates) == 0 {
+		return fmt.Errorf("the policy templates are empty")
+	}
+
+	b, err := yaml.Marshal(p)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func decodeObjects(b []byte) ([]yaml.MapSlice, error) {
+	var objects []yaml.MapSlice
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	for {
+		var o yaml.MapSlice
+		err := dec.Decode(&o)
+		if err == io.EOF {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(o) > 0 {
+			objects = append(objects, o)
+		}
+	}
+}
+
+// isPolicyTemplate returns true for the policy engine kinds, such as
+// ConfigurationPolicy or CertificatePolicy.
+func isPolicyTemplate(o yaml.MapSlice) bool {
+	var apiVersion, kind string
+	for _, item := range o {
+		switch item.Key {
+		case "apiVersion":
+			apiVersion = fmt.Sprint(item.Value)
+		case "kind":
+			kind = fmt.Sprint(item.Value)
+		}
+	}
+	return strings.HasPrefix(apiVersion, "policy.open-cluster-management.io/") && strings.HasSuffix(kind, "Policy")
+}
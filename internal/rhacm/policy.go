@@ -0,0 +1,419 @@
+// Package rhacm reads Red Hat Advanced Cluster Management policies and the
+// NIST 800-53 controls they enforce, and generates policies for the controls
+// that can be automated.
+package rhacm
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+	"gopkg.in/yaml.v2"
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/assessment"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// Annotations of a policy describing what it implements.
+const (
+	AnnotationStandards  = "policy.open-cluster-management.io/standards"
+	AnnotationCategories = "policy.open-cluster-management.io/categories"
+	AnnotationControls   = "policy.open-cluster-management.io/controls"
+	// LabelRootPolicy is set on the policies replicated to the managed
+	// clusters, to the namespace and name of the root policy
+	LabelRootPolicy = "policy.open-cluster-management.io/root-policy"
+)
+
+// Compliance states of a policy on a cluster.
+const (
+	Compliant    = "Compliant"
+	NonCompliant = "NonCompliant"
+)
+
+// Policy is a policy and the compliance of the clusters it is placed on.
+type Policy struct {
+	Name string `json:"name"`
+	// Path is the file the policy was read from
+	Path              string   `json:"path"`
+	Standards         []string `json:"standards"`
+	Controls          []string `json:"controls"`
+	RemediationAction string   `json:"remediation_action"`
+	Disabled          bool     `json:"disabled"`
+	// Clusters maps the clusters the policy is placed on to their
+	// compliance state
+	Clusters map[string]string `json:"clusters"`
+}
+
+// Status returns the implementation status implied by the compliance of the
+// clusters: complete when they are all compliant, none when none is, and
+// partial otherwise. Policies without compliance status return an empty
+// status.
+func (p Policy) Status() string {
+	if p.Disabled {
+		return ""
+	}
+	compliant, known := p.compliance()
+	switch {
+	case known == 0:
+		return ""
+	case compliant == known:
+		return parser.StatusComplete
+	case compliant == 0:
+		return parser.StatusNone
+	default:
+		return parser.StatusPartial
+	}
+}
+
+// compliance returns the number of compliant clusters and of clusters whose
+// compliance is known.
+func (p Policy) compliance() (compliant, known int) {
+	for _, state := range p.Clusters {
+		switch state {
+		case Compliant:
+			compliant++
+			known++
+		case NonCompliant:
+			known++
+		}
+	}
+	return compliant, known
+}
+
+// Summary describes the policy, e.g. "enforce, compliant on 2 of 3
+// clusters".
+func (p Policy) Summary() string {
+	action := strings.ToLower(p.RemediationAction)
+	if action == "" {
+		action = "inform"
+	}
+	if p.Disabled {
+		action += ", disabled"
+	}
+	if compliant, known := p.compliance(); known > 0 {
+		action += fmt.Sprintf(", compliant on %d of %d clusters", compliant, known)
+	}
+	return action
+}
+
+type object struct {
+	Kind     string `yaml:"kind"`
+	Metadata struct {
+		Name        string            `yaml:"name"`
+		Namespace   string            `yaml:"namespace"`
+		Labels      map[string]string `yaml:"labels"`
+		Annotations map[string]string `yaml:"annotations"`
+	} `yaml:"metadata"`
+	Spec struct {
+		RemediationAction string `yaml:"remediationAction"`
+		Disabled          bool   `yaml:"disabled"`
+	} `yaml:"spec"`
+	Status struct {
+		Compliant string `yaml:"compliant"`
+		Status    []struct {
+			ClusterName string `yaml:"clustername"`
+			Compliant   string `yaml:"compliant"`
+		} `yaml:"status"`
+	} `yaml:"status"`
+	Items []object `yaml:"items"`
+
+	// PolicyGenerator configuration
+	PolicyDefaults GeneratorPolicy   `yaml:"policyDefaults"`
+	Policies       []GeneratorPolicy `yaml:"policies"`
+}
+
+// Set is the policies read from files, indexed by the name of their root
+// policy so the copies replicated to the managed clusters are merged.
+type Set struct {
+	Policies map[string]*Policy
+	// Warnings are the files that couldn't be read and the controls that
+	// couldn't be mapped to a control key
+	Warnings []error
+}
+
+// NewSet returns an empty set.
+func NewSet() *Set {
+	return &Set{Policies: make(map[string]*Policy)}
+}
+
+// Parse reads the policies of a YAML stream, which may hold several
+// documents and lists of objects as written by `oc get policies -A -o yaml`.
+// PolicyGenerator configurations are read as the policies they generate.
+// Objects of other kinds are ignored.
+func (s *Set) Parse(r io.Reader, path string) error {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	for {
+		var o object
+		err := dec.Decode(&o)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		s.add(o, path)
+	}
+}
+
+func (s *Set) add(o object, path string) {
+	switch o.Kind {
+	case "List", "PolicyList":
+		for _, item := range o.Items {
+			s.add(item, path)
+		}
+	case "Policy":
+		s.addPolicy(o, path)
+	case "PolicyGenerator":
+		for _, gp := range o.Policies {
+			s.addGenerated(o.PolicyDefaults, gp, path)
+		}
+	}
+}
+
+func (s *Set) addPolicy(o object, path string) {
+	name := o.Metadata.Name
+	cluster := ""
+	if root := o.Metadata.Labels[LabelRootPolicy]; root != "" {
+		// the replicated policy lives in the namespace of its cluster
+		name = root[strings.Index(root, ".")+1:]
+		cluster = o.Metadata.Namespace
+	}
+
+	p := s.policy(name, path)
+	if cluster == "" {
+		// prefer the root policy over its copies
+		p.Path = path
+	}
+	if o.Spec.RemediationAction != "" {
+		p.RemediationAction = o.Spec.RemediationAction
+	}
+	p.Disabled = p.Disabled || o.Spec.Disabled
+	a := o.Metadata.Annotations
+	s.setControls(p, splitList(a[AnnotationStandards]), splitList(a[AnnotationControls]))
+
+	for _, st := range o.Status.Status {
+		p.Clusters[st.ClusterName] = st.Compliant
+	}
+	if cluster != "" && o.Status.Compliant != "" {
+		p.Clusters[cluster] = o.Status.Compliant
+	}
+}
+
+func (s *Set) addGenerated(defaults, gp GeneratorPolicy, path string) {
+	p := s.policy(gp.Name, path)
+	p.RemediationAction = firstNonEmpty(gp.RemediationAction, defaults.RemediationAction)
+	p.Disabled = gp.Disabled || defaults.Disabled
+	standards, controls := gp.Standards, gp.Controls
+	if standards == nil {
+		standards = defaults.Standards
+	}
+	if controls == nil {
+		controls = defaults.Controls
+	}
+	s.setControls(p, standards, controls)
+}
+
+// policy returns the policy of the set with a name, adding it if needed.
+func (s *Set) policy(name, path string) *Policy {
+	p, ok := s.Policies[name]
+	if !ok {
+		p = &Policy{Name: name, Path: path, Clusters: make(map[string]string)}
+		s.Policies[name] = p
+	}
+	return p
+}
+
+var controlRe = regexp.MustCompile(`^(?:NIST SP 800-53:?\s+)?([A-Z]{2}-[0-9]+)(?:\s*\(([0-9]+)\))?(?:\s|$|\()`)
+
+// setControls sets the controls of a policy out of its annotations, e.g.
+// "CM-2 Baseline Configuration, SC-28 Protection Of Information At Rest".
+// Policies of other standards are skipped.
+func (s *Set) setControls(p *Policy, standards, controls []string) {
+	if len(standards) > 0 {
+		p.Standards = standards
+	}
+	nist, other := false, false
+	for _, std := range p.Standards {
+		if strings.Contains(std, "800-53") {
+			nist = true
+		} else {
+			other = true
+		}
+	}
+	if len(p.Standards) > 0 && !nist {
+		return
+	}
+
+	pr := parser.NewParser()
+	for _, c := range controls {
+		m := controlRe.FindStringSubmatch(c)
+		var key string
+		var err error
+		if m != nil {
+			key = m[1]
+			if m[2] != "" {
+				key += " (" + m[2] + ")"
+			}
+			key, err = pr.ControlKey(key)
+		}
+		if m == nil || err != nil {
+			// the controls of other standards can't be told apart
+			if !other {
+				s.Warnings = append(s.Warnings, fmt.Errorf("policy %s: unknown NIST 800-53 control %q", p.Name, c))
+			}
+			continue
+		}
+		if !contains(p.Controls, key) {
+			p.Controls = append(p.Controls, key)
+		}
+	}
+	sort.Slice(p.Controls, func(i, j int) bool {
+		return sortorder.NaturalLess(p.Controls[i], p.Controls[j])
+	})
+}
+
+// Load reads the policies of a file, or of every YAML file under a
+// directory such as a GitOps repository. Files of a directory that can't be
+// read as YAML, such as templates, are reported as warnings.
+func (s *Set) Load(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return s.loadFile(path)
+	}
+	return filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if p != path && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if ext := strings.ToLower(filepath.Ext(p)); ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+		if err := s.loadFile(p); err != nil {
+			s.Warnings = append(s.Warnings, err)
+		}
+		return nil
+	})
+}
+
+func (s *Set) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := s.Parse(f, path); err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
+
+// Sorted returns the policies sorted by name.
+func (s *Set) Sorted() []*Policy {
+	var policies []*Policy
+	for _, p := range s.Policies {
+		policies = append(policies, p)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+	return policies
+}
+
+// ControlResults returns a result for every control of a policy, with the
+// policy as verification. The status of the controls is only set when the
+// compliance of the clusters is known, see Policy.Status.
+func (s *Set) ControlResults() ([]assessment.Result, []error) {
+	var results []assessment.Result
+	for _, p := range s.Sorted() {
+		for _, key := range p.Controls {
+			results = append(results, assessment.Result{
+				ControlKey: key,
+				Status:     p.Status(),
+				Verification: common.VerificationReference{
+					Key: "rhacm-" + p.Name,
+					GeneralReference: common.GeneralReference{
+						Name: fmt.Sprintf("%s (%s)", p.Name, p.Summary()),
+						Path: p.Path,
+						Type: "Policy",
+					},
+				},
+			})
+		}
+	}
+	return results, s.Warnings
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// ControlPolicies lists the policies enforcing a control.
+type ControlPolicies struct {
+	ControlKey string    `json:"control_key"`
+	Policies   []*Policy `json:"policies"`
+}
+
+// ByControl returns the policies of every control, in natural order.
+func (s *Set) ByControl() []ControlPolicies {
+	index := make(map[string]int)
+	var controls []ControlPolicies
+	for _, p := range s.Sorted() {
+		for _, key := range p.Controls {
+			i, ok := index[key]
+			if !ok {
+				i = len(controls)
+				index[key] = i
+				controls = append(controls, ControlPolicies{ControlKey: key})
+			}
+			controls[i].Policies = append(controls[i].Policies, p)
+		}
+	}
+	sort.Slice(controls, func(i, j int) bool {
+		return sortorder.NaturalLess(controls[i].ControlKey, controls[j].ControlKey)
+	})
+	return controls
+}
@@ -0,0 +1,246 @@
+package rhacm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+
+	"github.com/carlosmmatos/automate-compliance/internal/assessment"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+const gitops = `apiVersion: policy.open-cluster-management.io/v1
+kind: Policy
+metadata:
+  name: policy-etcdencryption
+  annotations:
+    policy.open-cluster-management.io/standards: NIST SP 800-53
+    policy.open-cluster-management.io/categories: SC System and Communications Protection
+    policy.open-cluster-management.io/controls: SC-28 Protection Of Information At Rest, SC-8(1)
+spec:
+  remediationAction: enforce
+  disabled: false
+---
+apiVersion: policy.open-cluster-management.io/v1
+kind: Policy
+metadata:
+  name: policy-csf
+  annotations:
+    policy.open-cluster-management.io/standards: NIST-CSF
+    policy.open-cluster-management.io/controls: PR.IP-1 Baseline Configuration
+spec:
+  remediationAction: inform
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unrelated
+`
+
+const exported = `apiVersion: v1
+kind: List
+items:
+- apiVersion: policy.open-cluster-management.io/v1
+  kind: Policy
+  metadata:
+    name: policy-certificatepolicy
+    namespace: policies
+    annotations:
+      policy.open-cluster-management.io/standards: NIST SP 800-53
+      policy.open-cluster-management.io/controls: SC-12 Cryptographic Key Establishment, Key rotation
+  spec:
+    remediationAction: inform
+  status:
+    compliant: NonCompliant
+    status:
+    - clustername: local-cluster
+      compliant: Compliant
+    - clustername: east
+      compliant: NonCompliant
+- apiVersion: policy.open-cluster-management.io/v1
+  kind: Policy
+  metadata:
+    name: policies.policy-etcdencryption
+    namespace: east
+    labels:
+      policy.open-cluster-management.io/root-policy: policies.policy-etcdencryption
+  spec:
+    remediationAction: enforce
+  status:
+    compliant: Compliant
+`
+
+const generator = `apiVersion: policy.open-cluster-management.io/v1
+kind: PolicyGenerator
+metadata:
+  name: policies
+policyDefaults:
+  namespace: policies
+  standards:
+  - NIST SP 800-53
+  controls:
+  - CM-2 Baseline Configuration
+  remediationAction: inform
+policies:
+- name: policy-audit
+  controls:
+  - AU-9 Protection of Audit Information
+  remediationAction: enforce
+- name: policy-baseline
+`
+
+func ref(name, summary, path string) common.VerificationReference {
+	return common.VerificationReference{Key: "rhacm-" + name, GeneralReference: common.GeneralReference{Name: name + " (" + summary + ")", Path: path, Type: "Policy"}}
+}
+
+func TestControlResults(t *testing.T) {
+	s := NewSet()
+	// the copy of the etcd policy is read before the root policy
+	files := []struct{ path, doc string }{
+		{"exported.yaml", exported},
+		{"gitops.yaml", gitops},
+		{"generator.yaml", generator},
+	}
+	for _, f := range files {
+		if err := s.Parse(strings.NewReader(f.doc), f.path); err != nil {
+			t.Fatalf("Set.Parse(%s) error = %v", f.path, err)
+		}
+	}
+
+	got, warnings := s.ControlResults()
+	audit := ref("policy-audit", "enforce", "generator.yaml")
+	baseline := ref("policy-baseline", "inform", "generator.yaml")
+	cert := ref("policy-certificatepolicy", "inform, compliant on 1 of 2 clusters", "exported.yaml")
+	etcd := ref("policy-etcdencryption", "enforce, compliant on 1 of 1 clusters", "gitops.yaml")
+	want := []assessment.Result{
+		{ControlKey: "AU-9", Verification: audit},
+		{ControlKey: "CM-2", Verification: baseline},
+		{ControlKey: "SC-12", Status: parser.StatusPartial, Verification: cert},
+		{ControlKey: "SC-8 (1)", Status: parser.StatusComplete, Verification: etcd},
+		{ControlKey: "SC-28", Status: parser.StatusComplete, Verification: etcd},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Set.ControlResults() = %v, want %v", got, want)
+	}
+	// "Key rotation" isn't a control
+	if len(warnings) != 1 {
+		t.Errorf("Set.ControlResults() warnings = %v, want 1", warnings)
+	}
+
+	var keys []string
+	for _, c := range s.ByControl() {
+		keys = append(keys, c.ControlKey)
+	}
+	if want := []string{"AU-9", "CM-2", "SC-8 (1)", "SC-12", "SC-28"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Set.ByControl() = %v, want %v", keys, want)
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	p := parser.NewParser()
+	rows := []parser.Row{
+		{Family: "CONFIGURATION MANAGEMENT", Control: "CM-2", Automatable: "Yes", Policy: "templates/baseline.yaml"},
+		{Family: "ACCESS CONTROL", Control: "AC-2 (1)", Automatable: "Yes", Policy: "templates/accounts.yaml"},
+		{Family: "ACCESS CONTROL", Control: "AC-3", Automatable: "Yes"},
+		{Family: "ACCESS CONTROL", Control: "AC-4", Policy: "templates/flow.yaml"},
+	}
+	for _, row := range rows {
+		if err := p.ParseRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	defaults := GeneratorPolicy{Namespace: "policies", RemediationAction: "inform", Placement: &Placement{ClusterSelectors: map[string]string{"env": "prod"}}}
+	g, skipped := NewGenerator("product", defaults, p.GetData(), p.GetDetails())
+	if want := []string{"AC-3"}; !reflect.DeepEqual(skipped, want) {
+		t.Errorf("NewGenerator() skipped = %v, want %v", skipped, want)
+	}
+	want := []GeneratorPolicy{
+		{Name: "policy-ac-2-1", Categories: []string{"AC Access Control"}, Controls: []string{"AC-2 (1)"}, Manifests: []Manifest{{"templates/accounts.yaml"}}},
+		{Name: "policy-cm-2", Categories: []string{"CM Configuration Management"}, Controls: []string{"CM-2"}, Manifests: []Manifest{{"templates/baseline.yaml"}}},
+	}
+	if !reflect.DeepEqual(g.Policies, want) {
+		t.Errorf("NewGenerator() policies = %+v, want %+v", g.Policies, want)
+	}
+
+	dir := t.TempDir()
+	templates := map[string]string{
+		"accounts.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: accounts\n  namespace: openshift-config\n",
+		"baseline.yaml": "apiVersion: policy.open-cluster-management.io/v1\nkind: ConfigurationPolicy\nmetadata:\n  name: baseline\nspec:\n  remediationAction: inform\n",
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "templates"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, doc := range templates {
+		if err := ioutil.WriteFile(filepath.Join(dir, "templates", name), []byte(doc), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	out := filepath.Join(dir, "generated")
+	if err := g.Write(out, dir); err != nil {
+		t.Fatalf("Generator.Write() error = %v", err)
+	}
+
+	// kustomize only reads the files of the kustomization directory
+	for _, name := range []string{GeneratorFile, KustomizationFile} {
+		b, err := ioutil.ReadFile(filepath.Join(out, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(b), "..") {
+			t.Errorf("%s references files outside of the output directory:\n%s", name, b)
+		}
+	}
+	b, err := ioutil.ReadFile(filepath.Join(out, GeneratorFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "path: templates/templates/accounts.yaml") {
+		t.Errorf("Generator.Write() didn't rewrite the template paths:\n%s", b)
+	}
+	if _, err := os.Stat(filepath.Join(out, TemplatesDir, "templates", "accounts.yaml")); err != nil {
+		t.Errorf("Generator.Write() didn't copy the templates: %v", err)
+	}
+	outside := *g
+	outside.Policies = []GeneratorPolicy{{Name: "policy-outside", Controls: []string{"AC-2 (1)"}, Manifests: []Manifest{{"../templates/accounts.yaml"}}}}
+	if err := outside.Write(filepath.Join(dir, "outside"), out); err == nil {
+		t.Errorf("Generator.Write() of a template outside of the template directory succeeded")
+	}
+
+	b, err = ioutil.ReadFile(filepath.Join(out, PoliciesDir, PlacementFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"kind: Placement", "env: prod", "kind: PlacementBinding", "name: product-placement-binding", "name: product-placement\n", "name: policy-cm-2"} {
+		if !strings.Contains(string(b), s) {
+			t.Errorf("%s doesn't contain %q:\n%s", PlacementFile, s, b)
+		}
+	}
+	b, err = ioutil.ReadFile(filepath.Join(out, PoliciesDir, "policy-ac-2-1.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"kind: ConfigurationPolicy", "complianceType: musthave", "name: accounts", "policy.open-cluster-management.io/controls: AC-2 (1)"} {
+		if !strings.Contains(string(b), s) {
+			t.Errorf("policy-ac-2-1.yaml doesn't contain %q:\n%s", s, b)
+		}
+	}
+
+	// The generated files can be read back
+	set := NewSet()
+	if err := set.Load(out); err != nil {
+		t.Fatalf("Set.Load() error = %v", err)
+	}
+	var keys []string
+	for _, c := range set.ByControl() {
+		keys = append(keys, c.ControlKey)
+	}
+	if want := []string{"AC-2 (1)", "CM-2"}; !reflect.DeepEqual(keys, want) || len(set.Warnings) > 0 {
+		t.Errorf("Set.ByControl() = %v, warnings %v, want %v", keys, set.Warnings, want)
+	}
+}
@@ -0,0 +1,250 @@
+package source
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// FirstDataLine is the spreadsheet row holding the first entry; row 1 is the
+// header.
+const FirstDataLine = 2
+
+// Columns maps each field of a parser.Row to a zero based column index of
+// the spreadsheet. A negative index means the column isn't present.
+type Columns struct {
+	Family      int
+	Control     int
+	Narrative   int
+	Status      int
+	Origin      int
+	Owner       int
+	Evidence    int
+	Milestone   int
+	Due         int
+	Automatable int
+	Policy      int
+}
+
+// DefaultColumns returns the layout of the NIST 800-53 example sheet, which
+// only has the family in column A and the control in column B.
+func DefaultColumns() Columns {
+	return Columns{
+		Family:      0,
+		Control:     1,
+		Narrative:   -1,
+		Status:      -1,
+		Origin:      -1,
+		Owner:       -1,
+		Evidence:    -1,
+		Milestone:   -1,
+		Due:         -1,
+		Automatable: -1,
+		Policy:      -1,
+	}
+}
+
+// ParseColumns parses a column specification such as
+// "family=A,control=B,status=F" on top of the default layout.
+func ParseColumns(spec string) (Columns, error) {
+	cols := DefaultColumns()
+	if strings.TrimSpace(spec) == "" {
+		return cols, nil
+	}
+
+	fields := cols.fields()
+	for _, entry := range strings.Split(spec, ",") {
+		kv := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+		if len(kv) != 2 {
+			return cols, fmt.Errorf("malformed column entry %q, expected name=LETTER", entry)
+		}
+		field, ok := fields[strings.ToLower(kv[0])]
+		if !ok {
+			return cols, fmt.Errorf("unknown column %q", kv[0])
+		}
+		idx, err := columnIndex(kv[1])
+		if err != nil {
+			return cols, err
+		}
+		*field = idx
+	}
+	return cols, nil
+}
+
+func (c *Columns) fields() map[string]*int {
+	return map[string]*int{
+		"family":      &c.Family,
+		"control":     &c.Control,
+		"narrative":   &c.Narrative,
+		"status":      &c.Status,
+		"origin":      &c.Origin,
+		"owner":       &c.Owner,
+		"evidence":    &c.Evidence,
+		"milestone":   &c.Milestone,
+		"due":         &c.Due,
+		"automatable": &c.Automatable,
+		"policy":      &c.Policy,
+	}
+}
+
+// columnIndex converts a spreadsheet column letter (A, B, ..., AA) into a
+// zero based index.
+func columnIndex(letters string) (int, error) {
+	letters = strings.ToUpper(strings.TrimSpace(letters))
+	if letters == "" {
+		return 0, fmt.Errorf("empty column letter")
+	}
+	idx := 0
+	for _, r := range letters {
+		if r < 'A' || r > 'Z' {
+			return 0, fmt.Errorf("invalid column letter %q", letters)
+		}
+		idx = idx*26 + int(r-'A') + 1
+	}
+	return idx - 1, nil
+}
+
+// Row builds a parser.Row out of the cells of a spreadsheet row.
+func (c Columns) Row(line int, cells []string) parser.Row {
+	return parser.Row{
+		Line:        line,
+		Family:      cell(cells, c.Family),
+		Control:     cell(cells, c.Control),
+		Narrative:   cell(cells, c.Narrative),
+		Status:      cell(cells, c.Status),
+		Origin:      cell(cells, c.Origin),
+		Owner:       cell(cells, c.Owner),
+		Evidence:    cell(cells, c.Evidence),
+		Milestone:   cell(cells, c.Milestone),
+		Due:         cell(cells, c.Due),
+		Automatable: cell(cells, c.Automatable),
+		Policy:      cell(cells, c.Policy),
+	}
+}
+
+func cell(cells []string, idx int) string {
+	if idx < 0 || idx >= len(cells) {
+		return ""
+	}
+	return strings.TrimSpace(cells[idx])
+}
+
+// FromValues converts the values returned by the Google Sheets API into rows.
+// The values are expected to start at FirstDataLine.
+func FromValues(values [][]interface{}, cols Columns) []parser.Row {
+	rows := make([]parser.Row, 0, len(values))
+	for i, v := range values {
+		cells := make([]string, len(v))
+		for j := range v {
+			cells[j] = fmt.Sprint(v[j])
+		}
+		rows = append(rows, cols.Row(FirstDataLine+i, cells))
+	}
+	return rows
+}
+
+// ReadCSV reads the rows of a CSV export of the spreadsheet. The first record
+// is the header and is skipped.
+func ReadCSV(r io.Reader, cols Columns) ([]parser.Row, error) {
+	cr := csv.NewReader(r)
+	// Exports don't pad trailing empty cells consistently
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []parser.Row
+	for i, rec := range records {
+		if i == 0 {
+			continue
+		}
+		rows = append(rows, cols.Row(FirstDataLine+i-1, rec))
+	}
+	return rows, nil
+}
+
+// Result is everything parsed out of the rows of a spreadsheet.
+type Result struct {
+	Data        parser.Data
+	Details     map[string]parser.Details
+	Diagnostics []parser.Diagnostic
+}
+
+// Err returns the first row that couldn't be parsed as an error.
+func (r Result) Err() error {
+	for _, d := range r.Diagnostics {
+		if d.Severity == parser.SeverityError {
+			return fmt.Errorf("row %d: found error in control %s: %s", d.Line, d.Control, d.Message)
+		}
+	}
+	return nil
+}
+
+// Errors returns the rows that couldn't be parsed.
+func (r Result) Errors() []parser.Diagnostic {
+	var errs []parser.Diagnostic
+	for _, d := range r.Diagnostics {
+		if d.Severity == parser.SeverityError {
+			errs = append(errs, d)
+		}
+	}
+	return errs
+}
+
+// Parse feeds rows into a new parser and returns the parsed data. Empty rows
+// are skipped. The first row that can't be parsed is returned as an error.
+func Parse(rows []parser.Row) (parser.Data, error) {
+	res := ParseRows(rows)
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
+
+// ParseRows feeds rows into a new parser and returns the parsed data along
+// with the problems found in the rows, sorted by line. Rows that can't be
+// parsed are skipped.
+func ParseRows(rows []parser.Row) Result {
+	p := parser.NewParser()
+	var diags []parser.Diagnostic
+	for _, row := range rows {
+		if row.Family == "" && row.Control == "" {
+			continue
+		}
+		if err := p.ParseRow(row); err != nil {
+			diags = append(diags, parser.Diagnostic{
+				Line:     row.Line,
+				Control:  row.Control,
+				Severity: parser.SeverityError,
+				Message:  err.Error(),
+				Owner:    strings.TrimSpace(row.Owner),
+			})
+		}
+	}
+	diags = append(diags, p.Warnings()...)
+	sort.SliceStable(diags, func(i, j int) bool {
+		return diags[i].Line < diags[j].Line
+	})
+	return Result{
+		Data:        p.GetData(),
+		Details:     p.GetDetails(),
+		Diagnostics: diags,
+	}
+}
+
+// Revision returns a short hash of the content of the rows, so runs over the
+// same revision of the spreadsheet can be told apart from runs after edits.
+func Revision(rows []parser.Row) string {
+	h := sha256.New()
+	for _, row := range rows {
+		fmt.Fprintf(h, "%q %q %q %q %q %q %q %q %q %q %q\n", row.Family, row.Control, row.Narrative, row.Status, row.Origin, row.Owner, row.Evidence, row.Milestone, row.Due, row.Automatable, row.Policy)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12]
+}
@@ -0,0 +1,93 @@
+package source
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+func TestParseColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		want    Columns
+		wantErr bool
+	}{
+		{
+			"Empty spec returns the default layout",
+			"",
+			DefaultColumns(),
+			false,
+		},
+		{
+			"Columns are set on top of the defaults",
+			"narrative=C, status=f,origin=AA",
+			Columns{Family: 0, Control: 1, Narrative: 2, Status: 5, Origin: 26, Owner: -1, Evidence: -1, Milestone: -1, Due: -1, Automatable: -1, Policy: -1},
+			false,
+		},
+		{
+			"Unknown columns return an error",
+			"colour=C",
+			Columns{},
+			true,
+		},
+		{
+			"Malformed columns return an error",
+			"status",
+			Columns{},
+			true,
+		},
+		{
+			"Invalid letters return an error",
+			"status=3",
+			Columns{},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseColumns(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseColumns() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseColumns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	csv := `Family,Control,Narrative,Status
+ACCESS CONTROL,AC-2a.,Accounts are reviewed,Implemented
+ACCESS CONTROL,AC-2 (1)
+`
+	cols, _ := ParseColumns("narrative=C,status=D")
+	got, err := ReadCSV(strings.NewReader(csv), cols)
+	if err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+	want := []parser.Row{
+		{Line: 2, Family: "ACCESS CONTROL", Control: "AC-2a.", Narrative: "Accounts are reviewed", Status: "Implemented"},
+		{Line: 3, Family: "ACCESS CONTROL", Control: "AC-2 (1)"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadCSV() = %v, want %v", got, want)
+	}
+}
+
+func TestParse(t *testing.T) {
+	rows := FromValues([][]interface{}{
+		{"ACCESS CONTROL", "AC-2a.", "."},
+		{"", "", "."},
+		{"ACCESS CONTROL", "AC-2)", "."},
+	}, DefaultColumns())
+
+	_, err := Parse(rows)
+	if err == nil || !strings.HasPrefix(err.Error(), "row 4:") {
+		t.Errorf("Parse() error = %v, want an error for row 4", err)
+	}
+}
@@ -0,0 +1,131 @@
+package stig
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+type xmlBenchmark struct {
+	ID         string     `xml:"id,attr"`
+	Title      string     `xml:"title"`
+	Version    string     `xml:"version"`
+	PlainTexts []xmlText  `xml:"plain-text"`
+	Groups     []xmlGroup `xml:"Group"`
+}
+
+type xmlText struct {
+	ID   string `xml:"id,attr"`
+	Text string `xml:",chardata"`
+}
+
+type xmlGroup struct {
+	ID    string  `xml:"id,attr"`
+	Title string  `xml:"title"`
+	Rule  xmlRule `xml:"Rule"`
+}
+
+type xmlRule struct {
+	ID           string     `xml:"id,attr"`
+	Severity     string     `xml:"severity,attr"`
+	Version      string     `xml:"version"`
+	Title        string     `xml:"title"`
+	Description  string     `xml:"description"`
+	Idents       []xmlIdent `xml:"ident"`
+	FixText      string     `xml:"fixtext"`
+	CheckContent string     `xml:"check>check-content"`
+}
+
+type xmlIdent struct {
+	System string `xml:"system,attr"`
+	Text   string `xml:",chardata"`
+}
+
+// ParseBenchmark reads a STIG as published by DISA in the XCCDF format
+// (U_*_STIG_*_Manual-xccdf.xml) and returns an empty checklist for it, with
+// every vulnerability not reviewed.
+func ParseBenchmark(r io.Reader) (*Checklist, error) {
+	var b xmlBenchmark
+	if err := xml.NewDecoder(r).Decode(&b); err != nil {
+		return nil, err
+	}
+	if len(b.Groups) == 0 {
+		return nil, fmt.Errorf("no STIG rules found")
+	}
+
+	var release string
+	for _, t := range b.PlainTexts {
+		if t.ID == "release-info" {
+			release = strings.TrimSpace(t.Text)
+		}
+	}
+	title := strings.TrimSpace(b.Title)
+	ref := fmt.Sprintf("%s :: Version %s, %s", title, strings.TrimSpace(b.Version), release)
+
+	s := STIG{Info: []Info{
+		{Name: "version", Data: strings.TrimSpace(b.Version)},
+		{Name: "classification", Data: "UNCLASSIFIED"},
+		{Name: "stigid", Data: b.ID},
+		{Name: "releaseinfo", Data: release},
+		{Name: "title", Data: title},
+	}}
+	for _, g := range b.Groups {
+		v := Vuln{
+			Data: []Attribute{
+				{AttrVulnNum, g.ID},
+				{AttrSeverity, g.Rule.Severity},
+				{AttrGroupTitle, strings.TrimSpace(g.Title)},
+				{AttrRuleID, g.Rule.ID},
+				{AttrRuleVer, strings.TrimSpace(g.Rule.Version)},
+				{AttrRuleTitle, strings.TrimSpace(g.Rule.Title)},
+				{AttrVulnDiscuss, vulnDiscussion(g.Rule.Description)},
+				{AttrCheckContent, strings.TrimSpace(g.Rule.CheckContent)},
+				{AttrFixText, strings.TrimSpace(g.Rule.FixText)},
+				{AttrSTIGRef, ref},
+			},
+			Status: StatusNotReviewed,
+		}
+		for _, id := range g.Rule.Idents {
+			if strings.HasSuffix(id.System, "/cci") {
+				v.Data = append(v.Data, Attribute{AttrCCIRef, strings.TrimSpace(id.Text)})
+			}
+		}
+		s.Vulns = append(s.Vulns, v)
+	}
+	return &Checklist{
+		Asset: Asset{Role: "None", AssetType: "Computing", WebOrDatabase: "false"},
+		STIGs: []STIG{s},
+	}, nil
+}
+
+// LoadBenchmark reads a DISA XCCDF STIG from a file.
+func LoadBenchmark(path string) (*Checklist, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	cl, err := ParseBenchmark(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return cl, nil
+}
+
+// vulnDiscussion extracts the discussion out of the description of a rule,
+// which DISA writes as escaped markup such as
+// "<VulnDiscussion>...</VulnDiscussion><FalsePositives></FalsePositives>".
+func vulnDiscussion(description string) string {
+	const open, end = "<VulnDiscussion>", "</VulnDiscussion>"
+	i := strings.Index(description, open)
+	if i < 0 {
+		return strings.TrimSpace(description)
+	}
+	rest := description[i+len(open):]
+	if j := strings.Index(rest, end); j >= 0 {
+		rest = rest[:j]
+	}
+	return strings.TrimSpace(rest)
+}
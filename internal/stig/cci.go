@@ -0,0 +1,237 @@
+package stig
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// cciTable maps CCIs to the NIST SP 800-53 Rev. 4 reference of the DISA CCI
+// list, one CCI per line. It only covers the CCIs most commonly referenced by
+// the operating system and container platform STIGs; the full list is loaded
+// with LoadCCIList.
+const cciTable = `
+CCI-000015  AC-2 (1)
+CCI-000016  AC-2 (2)
+CCI-000017  AC-2 (3)
+CCI-000018  AC-2 (4)
+CCI-000044  AC-7 a
+CCI-000048  AC-8 a
+CCI-000050  AC-8 b
+CCI-000056  AC-11 b
+CCI-000057  AC-11 a
+CCI-000058  AC-11 a
+CCI-000060  AC-11 (1)
+CCI-000067  AC-17 (1)
+CCI-000068  AC-17 (2)
+CCI-000126  AU-2 d
+CCI-000130  AU-3
+CCI-000131  AU-3
+CCI-000132  AU-3
+CCI-000133  AU-3
+CCI-000134  AU-3
+CCI-000135  AU-3 (1)
+CCI-000139  AU-5 a
+CCI-000140  AU-5 b
+CCI-000162  AU-9
+CCI-000163  AU-9
+CCI-000164  AU-9
+CCI-000169  AU-12 a
+CCI-000171  AU-12 b
+CCI-000172  AU-12 c
+CCI-000185  IA-5 (2) (a)
+CCI-000186  IA-5 (2) (b)
+CCI-000187  IA-5 (2) (c)
+CCI-000192  IA-5 (1) (a)
+CCI-000193  IA-5 (1) (a)
+CCI-000194  IA-5 (1) (a)
+CCI-000195  IA-5 (1) (b)
+CCI-000196  IA-5 (1) (c)
+CCI-000197  IA-5 (1) (c)
+CCI-000198  IA-5 (1) (d)
+CCI-000199  IA-5 (1) (d)
+CCI-000200  IA-5 (1) (e)
+CCI-000205  IA-5 (1) (a)
+CCI-000213  AC-3
+CCI-000366  CM-6 b
+CCI-000381  CM-7 a
+CCI-000382  CM-7 b
+CCI-000764  IA-2
+CCI-000765  IA-2 (1)
+CCI-000766  IA-2 (2)
+CCI-000767  IA-2 (3)
+CCI-000768  IA-2 (4)
+CCI-000778  IA-3
+CCI-000795  IA-4 e
+CCI-000803  IA-7
+CCI-000877  MA-4 c
+CCI-001133  SC-10
+CCI-001199  SC-28
+CCI-001314  SI-11 b
+CCI-001384  AC-8 c 1
+CCI-001403  AC-2 (4)
+CCI-001404  AC-2 (4)
+CCI-001405  AC-2 (4)
+CCI-001453  AC-17 (2)
+CCI-001464  AU-14 (1)
+CCI-001487  AU-3
+CCI-001493  AU-9
+CCI-001494  AU-9
+CCI-001495  AU-9
+CCI-001499  CM-5 (6)
+CCI-001744  CM-3 (5)
+CCI-001749  CM-5 (3)
+CCI-001764  CM-7 (2)
+CCI-001812  CM-11 (2)
+CCI-001814  CM-5 (1)
+CCI-001941  IA-2 (8)
+CCI-001942  IA-2 (9)
+CCI-001948  IA-2 (11)
+CCI-001953  IA-2 (12)
+CCI-001954  IA-2 (12)
+CCI-002038  IA-11
+CCI-002041  IA-5 (1) (f)
+CCI-002165  AC-3 (4)
+CCI-002235  AC-6 (10)
+CCI-002238  AC-7 b
+CCI-002314  AC-17 (1)
+CCI-002418  SC-8
+CCI-002450  SC-13
+CCI-002696  SI-6 a
+CCI-002884  MA-4 c
+`
+
+// CCIs maps Control Correlation Identifiers to the keys of the NIST 800-53
+// controls they implement.
+type CCIs map[string][]string
+
+// Controls returns the control keys of a CCI, or nil if it is unknown.
+func (c CCIs) Controls(cci string) []string {
+	return c[strings.ToUpper(strings.TrimSpace(cci))]
+}
+
+func (c CCIs) add(cci, index string) error {
+	key, err := controlKey(index)
+	if err != nil {
+		return err
+	}
+	for _, k := range c[cci] {
+		if k == key {
+			return nil
+		}
+	}
+	c[cci] = append(c[cci], key)
+	return nil
+}
+
+// DefaultCCIs returns the CCIs built into the binary.
+func DefaultCCIs() CCIs {
+	ccis := make(CCIs)
+	for _, line := range strings.Split(cciTable, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if err := ccis.add(fields[0], strings.Join(fields[1:], " ")); err != nil {
+			panic(fmt.Sprintf("stig: %s: %v", fields[0], err))
+		}
+	}
+	return ccis
+}
+
+var indexRe = regexp.MustCompile(`^([A-Z]{2}-[0-9]+)(?:\s*\(([0-9]+)\))?`)
+
+// controlKey returns the control key of an 800-53 reference of the CCI
+// list, e.g. "AC-1 a 1 (a)" is AC-1 and "IA-5 (1) (a)" is IA-5 (1).
+func controlKey(index string) (string, error) {
+	m := indexRe.FindStringSubmatch(strings.TrimSpace(index))
+	if m == nil {
+		return "", fmt.Errorf("unknown NIST 800-53 reference %q", index)
+	}
+	control := m[1]
+	if m[2] != "" {
+		control += " (" + m[2] + ")"
+	}
+	return parser.NewParser().ControlKey(control)
+}
+
+type xmlCCIItem struct {
+	ID         string            `xml:"id,attr"`
+	References []xmlCCIReference `xml:"references>reference"`
+}
+
+type xmlCCIReference struct {
+	Title   string `xml:"title,attr"`
+	Version string `xml:"version,attr"`
+	Index   string `xml:"index,attr"`
+}
+
+// ParseCCIList reads the DISA CCI list (U_CCI_List.xml). Each CCI is mapped
+// to its references in the most recent revision of NIST SP 800-53 the list
+// has for it.
+func ParseCCIList(r io.Reader) (CCIs, error) {
+	ccis := make(CCIs)
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "cci_item" {
+			continue
+		}
+		var item xmlCCIItem
+		if err := dec.DecodeElement(&item, &start); err != nil {
+			return nil, err
+		}
+
+		latest := -1
+		var indexes []string
+		for _, ref := range item.References {
+			// 800-53A references are assessment procedures, not controls
+			if !strings.Contains(ref.Title, "800-53") || strings.Contains(ref.Title, "800-53A") {
+				continue
+			}
+			v, _ := strconv.Atoi(ref.Version)
+			switch {
+			case v > latest:
+				latest, indexes = v, []string{ref.Index}
+			case v == latest:
+				indexes = append(indexes, ref.Index)
+			}
+		}
+		for _, index := range indexes {
+			if err := ccis.add(item.ID, index); err != nil {
+				return nil, fmt.Errorf("%s: %v", item.ID, err)
+			}
+		}
+	}
+	if len(ccis) == 0 {
+		return nil, fmt.Errorf("no CCI mapped to NIST 800-53 found")
+	}
+	return ccis, nil
+}
+
+// LoadCCIList reads the DISA CCI list from a file.
+func LoadCCIList(path string) (CCIs, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	ccis, err := ParseCCIList(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return ccis, nil
+}
@@ -0,0 +1,416 @@
+// Package stig reads and writes DISA STIG Viewer checklists, and maps their
+// findings to NIST 800-53 controls through the CCIs of each vulnerability.
+package stig
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+	"vbom.ml/util/sortorder"
+
+	"github.com/carlosmmatos/automate-compliance/internal/assessment"
+	"github.com/carlosmmatos/automate-compliance/internal/opencontrol"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// Status of a vulnerability, as written in .ckl files.
+const (
+	StatusNotAFinding   = "NotAFinding"
+	StatusOpen          = "Open"
+	StatusNotApplicable = "Not_Applicable"
+	StatusNotReviewed   = "Not_Reviewed"
+)
+
+// Attributes of a vulnerability (VULN_ATTRIBUTE).
+const (
+	AttrVulnNum      = "Vuln_Num"
+	AttrSeverity     = "Severity"
+	AttrGroupTitle   = "Group_Title"
+	AttrRuleID       = "Rule_ID"
+	AttrRuleVer      = "Rule_Ver"
+	AttrRuleTitle    = "Rule_Title"
+	AttrVulnDiscuss  = "Vuln_Discuss"
+	AttrCheckContent = "Check_Content"
+	AttrFixText      = "Fix_Text"
+	AttrSTIGRef      = "STIGRef"
+	AttrCCIRef       = "CCI_REF"
+)
+
+// Checklist is a STIG Viewer checklist: the asset being reviewed and the
+// STIGs it is reviewed against.
+type Checklist struct {
+	XMLName xml.Name `xml:"CHECKLIST"`
+	Asset   Asset    `xml:"ASSET"`
+	STIGs   []STIG   `xml:"STIGS>iSTIG"`
+}
+
+// Asset describes the reviewed system.
+type Asset struct {
+	Role          string `xml:"ROLE"`
+	AssetType     string `xml:"ASSET_TYPE"`
+	HostName      string `xml:"HOST_NAME"`
+	HostIP        string `xml:"HOST_IP"`
+	HostMAC       string `xml:"HOST_MAC"`
+	HostFQDN      string `xml:"HOST_FQDN"`
+	TargetComment string `xml:"TARGET_COMMENT"`
+	TechArea      string `xml:"TECH_AREA"`
+	TargetKey     string `xml:"TARGET_KEY"`
+	WebOrDatabase string `xml:"WEB_OR_DATABASE"`
+	WebDBSite     string `xml:"WEB_DB_SITE"`
+	WebDBInstance string `xml:"WEB_DB_INSTANCE"`
+}
+
+// STIG is a STIG of the checklist and its vulnerabilities.
+type STIG struct {
+	Info  []Info `xml:"STIG_INFO>SI_DATA"`
+	Vulns []Vuln `xml:"VULN"`
+}
+
+// Info is a property of a STIG, such as its title or version.
+type Info struct {
+	Name string `xml:"SID_NAME"`
+	Data string `xml:"SID_DATA,omitempty"`
+}
+
+// Vuln is a vulnerability of a STIG and the outcome of its review.
+type Vuln struct {
+	Data                  []Attribute `xml:"STIG_DATA"`
+	Status                string      `xml:"STATUS"`
+	FindingDetails        string      `xml:"FINDING_DETAILS"`
+	Comments              string      `xml:"COMMENTS"`
+	SeverityOverride      string      `xml:"SEVERITY_OVERRIDE"`
+	SeverityJustification string      `xml:"SEVERITY_JUSTIFICATION"`
+}
+
+// Attribute is a piece of data about a vulnerability, such as its rule title
+// or one of its CCIs.
+type Attribute struct {
+	Name string `xml:"VULN_ATTRIBUTE"`
+	Data string `xml:"ATTRIBUTE_DATA"`
+}
+
+// Get returns the first value of an attribute.
+func (v Vuln) Get(name string) string {
+	for _, a := range v.Data {
+		if a.Name == name {
+			return a.Data
+		}
+	}
+	return ""
+}
+
+// ID returns the vulnerability number, e.g. V-230221.
+func (v Vuln) ID() string {
+	return v.Get(AttrVulnNum)
+}
+
+// CCIs returns the CCIs the vulnerability references.
+func (v Vuln) CCIs() []string {
+	var ccis []string
+	for _, a := range v.Data {
+		if a.Name == AttrCCIRef && a.Data != "" {
+			ccis = append(ccis, strings.TrimSpace(a.Data))
+		}
+	}
+	return ccis
+}
+
+// ParseCKL reads a checklist in the XML format of STIG Viewer 2 (.ckl).
+func ParseCKL(r io.Reader) (*Checklist, error) {
+	var cl Checklist
+	if err := xml.NewDecoder(r).Decode(&cl); err != nil {
+		return nil, err
+	}
+	return &cl, nil
+}
+
+type jsonChecklist struct {
+	Target struct {
+		HostName  string `json:"host_name"`
+		IPAddress string `json:"ip_address"`
+		FQDN      string `json:"fqdn"`
+		Comments  string `json:"comments"`
+	} `json:"target_data"`
+	STIGs []struct {
+		Name        string     `json:"stig_name"`
+		ID          string     `json:"stig_id"`
+		Version     string     `json:"version"`
+		ReleaseInfo string     `json:"release_info"`
+		Rules       []jsonRule `json:"rules"`
+	} `json:"stigs"`
+}
+
+type jsonRule struct {
+	GroupID        string   `json:"group_id"`
+	Severity       string   `json:"severity"`
+	GroupTitle     string   `json:"group_title"`
+	RuleID         string   `json:"rule_id"`
+	RuleVersion    string   `json:"rule_version"`
+	RuleTitle      string   `json:"rule_title"`
+	Discussion     string   `json:"discussion"`
+	CheckContent   string   `json:"check_content"`
+	FixText        string   `json:"fix_text"`
+	CCIs           []string `json:"ccis"`
+	Status         string   `json:"status"`
+	FindingDetails string   `json:"finding_details"`
+	Comments       string   `json:"comments"`
+}
+
+// cklbStatus maps the statuses of .cklb files to those of .ckl files.
+var cklbStatus = map[string]string{
+	"not_a_finding":  StatusNotAFinding,
+	"open":           StatusOpen,
+	"not_applicable": StatusNotApplicable,
+	"not_reviewed":   StatusNotReviewed,
+}
+
+// ParseCKLB reads a checklist in the JSON format of STIG Viewer 3 (.cklb).
+func ParseCKLB(r io.Reader) (*Checklist, error) {
+	var jc jsonChecklist
+	if err := json.NewDecoder(r).Decode(&jc); err != nil {
+		return nil, err
+	}
+
+	cl := &Checklist{Asset: Asset{
+		HostName:      jc.Target.HostName,
+		HostIP:        jc.Target.IPAddress,
+		HostFQDN:      jc.Target.FQDN,
+		TargetComment: jc.Target.Comments,
+	}}
+	for _, js := range jc.STIGs {
+		s := STIG{Info: []Info{
+			{Name: "version", Data: js.Version},
+			{Name: "stigid", Data: js.ID},
+			{Name: "releaseinfo", Data: js.ReleaseInfo},
+			{Name: "title", Data: js.Name},
+		}}
+		for _, r := range js.Rules {
+			status, ok := cklbStatus[r.Status]
+			if !ok {
+				status = r.Status
+			}
+			v := Vuln{
+				Data: []Attribute{
+					{AttrVulnNum, r.GroupID},
+					{AttrSeverity, r.Severity},
+					{AttrGroupTitle, r.GroupTitle},
+					{AttrRuleID, r.RuleID},
+					{AttrRuleVer, r.RuleVersion},
+					{AttrRuleTitle, r.RuleTitle},
+					{AttrVulnDiscuss, r.Discussion},
+					{AttrCheckContent, r.CheckContent},
+					{AttrFixText, r.FixText},
+				},
+				Status:         status,
+				FindingDetails: r.FindingDetails,
+				Comments:       r.Comments,
+			}
+			for _, cci := range r.CCIs {
+				v.Data = append(v.Data, Attribute{AttrCCIRef, cci})
+			}
+			s.Vulns = append(s.Vulns, v)
+		}
+		cl.STIGs = append(cl.STIGs, s)
+	}
+	return cl, nil
+}
+
+// Load reads a .ckl or .cklb checklist.
+func Load(path string) (*Checklist, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var cl *Checklist
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ckl":
+		cl, err = ParseCKL(f)
+	case ".cklb":
+		cl, err = ParseCKLB(f)
+	default:
+		return nil, fmt.Errorf("%s: unknown checklist format, expected a .ckl or .cklb file", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return cl, nil
+}
+
+// WriteCKL writes the checklist in the XML format of STIG Viewer 2.
+func (cl *Checklist) WriteCKL(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "\t")
+	if err := enc.Encode(cl); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// Status maps the status of a vulnerability to the implementation status it
+// implies for its controls. Vulnerabilities that weren't reviewed return an
+// empty status.
+func Status(status string) string {
+	switch status {
+	case StatusNotAFinding:
+		return parser.StatusComplete
+	case StatusOpen:
+		return parser.StatusNone
+	case StatusNotApplicable:
+		return parser.StatusNotApplicable
+	default:
+		return ""
+	}
+}
+
+// controls returns the control keys of the CCIs of a vulnerability, in
+// natural order, along with the CCIs that aren't known.
+func (v Vuln) controls(ccis CCIs) ([]string, []string) {
+	var keys, unknown []string
+	seen := make(map[string]bool)
+	for _, cci := range v.CCIs() {
+		controls := ccis.Controls(cci)
+		if controls == nil {
+			unknown = append(unknown, cci)
+		}
+		for _, k := range controls {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return sortorder.NaturalLess(keys[i], keys[j])
+	})
+	return keys, unknown
+}
+
+// Unmapped returns the number of vulnerabilities referencing CCIs that aren't
+// mapped to a control, whose controls are incomplete, and the number of
+// vulnerabilities.
+func (cl *Checklist) Unmapped(ccis CCIs) (unmapped, total int) {
+	for _, s := range cl.STIGs {
+		for _, v := range s.Vulns {
+			total++
+			if _, unknown := v.controls(ccis); len(unknown) > 0 {
+				unmapped++
+			}
+		}
+	}
+	return unmapped, total
+}
+
+// ControlResults returns a result for every control mapped from the CCIs of
+// a reviewed vulnerability, with the vulnerability as verification. A
+// control is complete when all of its vulnerabilities are not a finding.
+func (cl *Checklist) ControlResults(path string, ccis CCIs) ([]assessment.Result, []error) {
+	var results []assessment.Result
+	var errs []error
+	warned := make(map[string]bool)
+	for _, s := range cl.STIGs {
+		for _, v := range s.Vulns {
+			status := Status(v.Status)
+			if status == "" {
+				continue
+			}
+			keys, unknown := v.controls(ccis)
+			for _, cci := range unknown {
+				if !warned[cci] {
+					warned[cci] = true
+					errs = append(errs, fmt.Errorf("%s: %s isn't mapped to a NIST 800-53 control, the DISA CCI list may be needed", v.ID(), cci))
+				}
+			}
+
+			name := v.Get(AttrRuleTitle)
+			if name == "" {
+				name = v.ID()
+			}
+			for _, key := range keys {
+				results = append(results, assessment.Result{
+					ControlKey: key,
+					Status:     status,
+					Verification: common.VerificationReference{
+						Key: "stig-" + v.ID(),
+						GeneralReference: common.GeneralReference{
+							Name: fmt.Sprintf("%s (%s)", name, v.Status),
+							Path: path,
+							Type: "STIG",
+						},
+					},
+				})
+			}
+		}
+	}
+	return results, errs
+}
+
+// Prefill writes the narratives of the controls mapped from the CCIs of each
+// vulnerability into its finding details, so the reviewer starts from what
+// the assessment already says. Finding details that were already filled in
+// are kept. It returns the number of vulnerabilities filled in.
+func (cl *Checklist) Prefill(c *v3c.Component, ccis CCIs) int {
+	satisfies := make(map[string]v3c.Satisfies)
+	for _, s := range c.Satisfies {
+		satisfies[s.ControlKey] = s
+	}
+
+	filled := 0
+	for i := range cl.STIGs {
+		for j := range cl.STIGs[i].Vulns {
+			v := &cl.STIGs[i].Vulns[j]
+			if strings.TrimSpace(v.FindingDetails) != "" {
+				continue
+			}
+			keys, _ := v.controls(ccis)
+			var sections []string
+			for _, key := range keys {
+				if s, ok := satisfies[key]; ok {
+					sections = append(sections, findingDetails(s))
+				}
+			}
+			if len(sections) > 0 {
+				v.FindingDetails = strings.Join(sections, "\n\n")
+				filled++
+			}
+		}
+	}
+	return filled
+}
+
+// findingDetails describes how a control is implemented, e.g.
+//
+//	AC-2 (partial):
+//	a. Accounts are reviewed
+func findingDetails(s v3c.Satisfies) string {
+	var b strings.Builder
+	b.WriteString(s.ControlKey)
+	if s.ImplementationStatus != "" {
+		fmt.Fprintf(&b, " (%s)", s.ImplementationStatus)
+	}
+	b.WriteString(":")
+
+	narratives := append([]v3c.NarrativeSection(nil), s.Narrative...)
+	opencontrol.SortNarratives(narratives)
+	for _, n := range narratives {
+		b.WriteString("\n")
+		if n.Key != "" {
+			b.WriteString(n.Key + ". ")
+		}
+		b.WriteString(strings.TrimSpace(n.Text))
+	}
+	return b.String()
+}
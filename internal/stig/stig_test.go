@@ -0,0 +1,213 @@
+package stig
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/assessment"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+const ckl = `<?xml version="1.0" encoding="UTF-8"?>
+<!--DISA STIG Viewer :: 2.17-->
+<CHECKLIST>
+	<ASSET><ROLE>None</ROLE><ASSET_TYPE>Computing</ASSET_TYPE><HOST_NAME>node1</HOST_NAME></ASSET>
+	<STIGS><iSTIG>
+		<STIG_INFO><SI_DATA><SID_NAME>version</SID_NAME><SID_DATA>1</SID_DATA></SI_DATA></STIG_INFO>
+		<VULN>
+			<STIG_DATA><VULN_ATTRIBUTE>Vuln_Num</VULN_ATTRIBUTE><ATTRIBUTE_DATA>V-230244</ATTRIBUTE_DATA></STIG_DATA>
+			<STIG_DATA><VULN_ATTRIBUTE>Rule_Title</VULN_ATTRIBUTE><ATTRIBUTE_DATA>SSH must time out idle sessions</ATTRIBUTE_DATA></STIG_DATA>
+			<STIG_DATA><VULN_ATTRIBUTE>CCI_REF</VULN_ATTRIBUTE><ATTRIBUTE_DATA>CCI-001133</ATTRIBUTE_DATA></STIG_DATA>
+			<STIG_DATA><VULN_ATTRIBUTE>CCI_REF</VULN_ATTRIBUTE><ATTRIBUTE_DATA>CCI-002361</ATTRIBUTE_DATA></STIG_DATA>
+			<STATUS>NotAFinding</STATUS>
+			<FINDING_DETAILS>ClientAliveInterval is 600</FINDING_DETAILS>
+			<COMMENTS></COMMENTS>
+		</VULN>
+		<VULN>
+			<STIG_DATA><VULN_ATTRIBUTE>Vuln_Num</VULN_ATTRIBUTE><ATTRIBUTE_DATA>V-230264</ATTRIBUTE_DATA></STIG_DATA>
+			<STIG_DATA><VULN_ATTRIBUTE>CCI_REF</VULN_ATTRIBUTE><ATTRIBUTE_DATA>CCI-001749</ATTRIBUTE_DATA></STIG_DATA>
+			<STATUS>Open</STATUS>
+		</VULN>
+		<VULN>
+			<STIG_DATA><VULN_ATTRIBUTE>Vuln_Num</VULN_ATTRIBUTE><ATTRIBUTE_DATA>V-230221</ATTRIBUTE_DATA></STIG_DATA>
+			<STIG_DATA><VULN_ATTRIBUTE>CCI_REF</VULN_ATTRIBUTE><ATTRIBUTE_DATA>CCI-000366</ATTRIBUTE_DATA></STIG_DATA>
+			<STATUS>Not_Reviewed</STATUS>
+		</VULN>
+	</iSTIG></STIGS>
+</CHECKLIST>`
+
+const cklb = `{
+  "title": "node1",
+  "target_data": {"host_name": "node1"},
+  "stigs": [{
+    "stig_name": "Red Hat Enterprise Linux 8 STIG",
+    "version": "1",
+    "rules": [
+      {"group_id": "V-230264", "rule_title": "Packages must be signed", "ccis": ["CCI-001749"], "status": "not_applicable"},
+      {"group_id": "V-230221", "ccis": ["CCI-000366"], "status": "not_reviewed"}
+    ]
+  }]
+}`
+
+const benchmark = `<?xml version="1.0" encoding="utf-8"?>
+<Benchmark xmlns="http://checklists.nist.gov/xccdf/1.1" id="RHEL_8_STIG">
+  <title>Red Hat Enterprise Linux 8 Security Technical Implementation Guide</title>
+  <plain-text id="release-info">Release: 12 Benchmark Date: 25 Oct 2023</plain-text>
+  <version>1</version>
+  <Group id="V-230244">
+    <title>SRG-OS-000163-GPOS-00072</title>
+    <Rule id="SV-230244r917708_rule" severity="medium">
+      <version>RHEL-08-010200</version>
+      <title>SSH must time out idle sessions</title>
+      <description>&lt;VulnDiscussion&gt;Idle sessions are a risk.&lt;/VulnDiscussion&gt;&lt;FalsePositives&gt;&lt;/FalsePositives&gt;</description>
+      <ident system="http://cyber.mil/legacy">V-72237</ident>
+      <ident system="http://cyber.mil/cci">CCI-001133</ident>
+      <fixtext fixref="F-32888r917707_fix">Set ClientAliveInterval.</fixtext>
+      <check system="C-32913r917706_chk"><check-content>Verify ClientAliveInterval.</check-content></check>
+    </Rule>
+  </Group>
+</Benchmark>`
+
+func verification(id, name string) common.VerificationReference {
+	return common.VerificationReference{Key: "stig-" + id, GeneralReference: common.GeneralReference{Name: name, Path: "node1.ckl", Type: "STIG"}}
+}
+
+func TestControlResults(t *testing.T) {
+	tests := []struct {
+		name         string
+		parse        func(string) (*Checklist, error)
+		doc          string
+		want         []assessment.Result
+		wantWarnings int
+		wantUnmapped int
+	}{
+		{
+			"STIG Viewer 2 checklist",
+			func(s string) (*Checklist, error) { return ParseCKL(strings.NewReader(s)) },
+			ckl,
+			[]assessment.Result{
+				{ControlKey: "SC-10", Status: parser.StatusComplete, Verification: verification("V-230244", "SSH must time out idle sessions (NotAFinding)")},
+				{ControlKey: "CM-5 (3)", Status: parser.StatusNone, Verification: verification("V-230264", "V-230264 (Open)")},
+			},
+			1,
+			1,
+		},
+		{
+			"STIG Viewer 3 checklist",
+			func(s string) (*Checklist, error) { return ParseCKLB(strings.NewReader(s)) },
+			cklb,
+			[]assessment.Result{
+				{ControlKey: "CM-5 (3)", Status: parser.StatusNotApplicable, Verification: verification("V-230264", "Packages must be signed (Not_Applicable)")},
+			},
+			0,
+			0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, err := tt.parse(tt.doc)
+			if err != nil {
+				t.Fatalf("parse error = %v", err)
+			}
+			got, warnings := cl.ControlResults("node1.ckl", DefaultCCIs())
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Checklist.ControlResults() = %v, want %v", got, tt.want)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("Checklist.ControlResults() warnings = %v, want %d", warnings, tt.wantWarnings)
+			}
+			if unmapped, _ := cl.Unmapped(DefaultCCIs()); unmapped != tt.wantUnmapped {
+				t.Errorf("Checklist.Unmapped() = %d, want %d", unmapped, tt.wantUnmapped)
+			}
+		})
+	}
+}
+
+func TestParseCCIList(t *testing.T) {
+	list := `<?xml version="1.0" encoding="utf-8"?>
+<cci_list xmlns="http://iase.disa.mil/cci">
+  <cci_items>
+    <cci_item id="CCI-000001">
+      <references>
+        <reference creator="NIST" title="NIST SP 800-53" version="3" index="AC-1 a" />
+        <reference creator="NIST" title="NIST SP 800-53 Revision 4" version="4" index="AC-1 a 1 (a)" />
+        <reference creator="NIST" title="NIST SP 800-53A" version="1" index="AC-1.1 (i and ii)" />
+      </references>
+    </cci_item>
+    <cci_item id="CCI-000185">
+      <references>
+        <reference creator="NIST" title="NIST SP 800-53 Revision 4" version="4" index="IA-5 (2) (a)" />
+        <reference creator="NIST" title="NIST SP 800-53 Revision 5" version="5" index="IA-5 (2) (b) (1)" />
+      </references>
+    </cci_item>
+    <cci_item id="CCI-009999"><references /></cci_item>
+  </cci_items>
+</cci_list>`
+	got, err := ParseCCIList(strings.NewReader(list))
+	if err != nil {
+		t.Fatalf("ParseCCIList() error = %v", err)
+	}
+	want := CCIs{"CCI-000001": {"AC-1"}, "CCI-000185": {"IA-5 (2)"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseCCIList() = %v, want %v", got, want)
+	}
+}
+
+func TestPrefill(t *testing.T) {
+	cl, err := ParseBenchmark(strings.NewReader(benchmark))
+	if err != nil {
+		t.Fatalf("ParseBenchmark() error = %v", err)
+	}
+	c := &v3c.Component{Satisfies: []v3c.Satisfies{{
+		ControlKey:           "SC-10",
+		ImplementationStatus: parser.StatusPartial,
+		Narrative: []v3c.NarrativeSection{
+			{Key: "b", Text: "Sessions of the console time out"},
+			{Key: "a", Text: "SSH sessions time out after 10 minutes"},
+		},
+	}}}
+	if got := cl.Prefill(c, DefaultCCIs()); got != 1 {
+		t.Errorf("Checklist.Prefill() = %d, want 1", got)
+	}
+
+	var buf bytes.Buffer
+	if err := cl.WriteCKL(&buf); err != nil {
+		t.Fatalf("Checklist.WriteCKL() error = %v", err)
+	}
+	got, err := ParseCKL(&buf)
+	if err != nil {
+		t.Fatalf("ParseCKL() error = %v", err)
+	}
+
+	v := got.STIGs[0].Vulns[0]
+	wantDetails := "SC-10 (partial):\na. SSH sessions time out after 10 minutes\nb. Sessions of the console time out"
+	if v.FindingDetails != wantDetails {
+		t.Errorf("FINDING_DETAILS = %q, want %q", v.FindingDetails, wantDetails)
+	}
+	wantAttrs := map[string]string{
+		AttrVulnNum:     "V-230244",
+		AttrSeverity:    "medium",
+		AttrRuleVer:     "RHEL-08-010200",
+		AttrVulnDiscuss: "Idle sessions are a risk.",
+		AttrCCIRef:      "CCI-001133",
+		AttrSTIGRef:     "Red Hat Enterprise Linux 8 Security Technical Implementation Guide :: Version 1, Release: 12 Benchmark Date: 25 Oct 2023",
+	}
+	for name, want := range wantAttrs {
+		if got := v.Get(name); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if v.Status != StatusNotReviewed {
+		t.Errorf("STATUS = %q, want %q", v.Status, StatusNotReviewed)
+	}
+
+	// Finding details written by a reviewer are kept
+	if got := got.Prefill(c, DefaultCCIs()); got != 0 {
+		t.Errorf("Checklist.Prefill() on a filled checklist = %d, want 0", got)
+	}
+}
@@ -0,0 +1,226 @@
+// Package xccdf reads the XCCDF results and ARF reports written by OpenSCAP
+// and turns them into the status of the NIST 800-53 controls the rules
+// reference.
+package xccdf
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+
+	"github.com/carlosmmatos/automate-compliance/internal/assessment"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+// Rule is a rule of the benchmark.
+type Rule struct {
+	ID    string
+	Title string
+	// Controls are the keys of the NIST 800-53 controls the rule references
+	Controls []string
+}
+
+// RuleResult is the result of evaluating a rule.
+type RuleResult struct {
+	RuleID string
+	Result string
+}
+
+// Report holds the rules and results found in an XCCDF results file or an
+// ARF report.
+type Report struct {
+	Rules   map[string]Rule
+	Results []RuleResult
+	// Warnings are the references that couldn't be mapped to a control
+	Warnings []error
+}
+
+type xmlRule struct {
+	ID         string         `xml:"id,attr"`
+	Title      string         `xml:"title"`
+	References []xmlReference `xml:"reference"`
+}
+
+type xmlReference struct {
+	Href string `xml:"href,attr"`
+	Text string `xml:",chardata"`
+}
+
+type xmlRuleResult struct {
+	IDRef  string `xml:"idref,attr"`
+	Result string `xml:"result"`
+}
+
+// Parse reads an XCCDF document, either results as written by
+// `oscap xccdf eval --results` or an ARF report as written by
+// `oscap xccdf eval --results-arf`. Rules and rule results are read wherever
+// they are in the document, so both layouts are handled the same way.
+func Parse(r io.Reader) (*Report, error) {
+	report := &Report{Rules: make(map[string]Rule)}
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "Rule":
+			var xr xmlRule
+			if err := dec.DecodeElement(&xr, &start); err != nil {
+				return nil, err
+			}
+			report.addRule(xr)
+		case "rule-result":
+			var xr xmlRuleResult
+			if err := dec.DecodeElement(&xr, &start); err != nil {
+				return nil, err
+			}
+			report.Results = append(report.Results, RuleResult{RuleID: xr.IDRef, Result: strings.TrimSpace(xr.Result)})
+		}
+	}
+	return report, nil
+}
+
+func (r *Report) addRule(xr xmlRule) {
+	rule := Rule{ID: xr.ID, Title: strings.TrimSpace(xr.Title)}
+	seen := make(map[string]bool)
+	for _, ref := range xr.References {
+		if !strings.Contains(ref.Href, "800-53") {
+			continue
+		}
+		keys, errs := ControlKeys(ref.Text)
+		for _, err := range errs {
+			r.Warnings = append(r.Warnings, fmt.Errorf("rule %s: %v", xr.ID, err))
+		}
+		for _, k := range keys {
+			if !seen[k] {
+				seen[k] = true
+				rule.Controls = append(rule.Controls, k)
+			}
+		}
+	}
+	r.Rules[rule.ID] = rule
+}
+
+// Load reads an XCCDF results file or an ARF report.
+func Load(path string) (*Report, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	report, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return report, nil
+}
+
+var referenceRe = regexp.MustCompile(`^([A-Z]{2})-([0-9]+)\s*(?:\(([0-9]+)\))?(?:\([a-z0-9]+\))*$`)
+
+// ControlKeys returns the control keys of a NIST 800-53 reference of a rule,
+// e.g. "AC-2(a),AC-2(1),IA-5(1)(c)" references AC-2, AC-2 (1) and IA-5 (1).
+func ControlKeys(reference string) ([]string, []error) {
+	var keys []string
+	var errs []error
+	p := parser.NewParser()
+	for _, ref := range strings.Split(reference, ",") {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+		m := referenceRe.FindStringSubmatch(ref)
+		if m == nil {
+			errs = append(errs, fmt.Errorf("unknown NIST 800-53 reference %q", ref))
+			continue
+		}
+		control := m[1] + "-" + m[2]
+		if m[3] != "" {
+			control += " (" + m[3] + ")"
+		}
+		key, err := p.ControlKey(control)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unknown NIST 800-53 reference %q", ref))
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, errs
+}
+
+// Status maps the result of a rule to the implementation status it implies
+// for the controls the rule references. Results that don't tell anything,
+// such as notchecked or notselected, return an empty status.
+func Status(result string) string {
+	switch result {
+	case "pass", "fixed":
+		return parser.StatusComplete
+	case "fail", "error":
+		return parser.StatusNone
+	case "notapplicable":
+		return parser.StatusNotApplicable
+	default:
+		return ""
+	}
+}
+
+// ControlResults returns a result for every control referenced by an
+// evaluated rule, with the rule as verification. The status of a control is
+// then the combination of the results of all of its rules: complete when they
+// all pass, partial when some fail and none when they all fail.
+func (r *Report) ControlResults(path string) ([]assessment.Result, []error) {
+	errs := append([]error(nil), r.Warnings...)
+	if len(r.Rules) == 0 && len(r.Results) > 0 {
+		errs = append(errs, fmt.Errorf("%s has no rule definitions, the benchmark is needed to map rules to controls", path))
+	}
+
+	var results []assessment.Result
+	for _, rr := range r.Results {
+		status := Status(rr.Result)
+		if status == "" {
+			continue
+		}
+		rule := r.Rules[rr.RuleID]
+		name := rule.Title
+		if name == "" {
+			name = rr.RuleID
+		}
+		for _, key := range rule.Controls {
+			results = append(results, assessment.Result{
+				ControlKey: key,
+				Status:     status,
+				Verification: common.VerificationReference{
+					Key: "xccdf-" + shortID(rr.RuleID),
+					GeneralReference: common.GeneralReference{
+						Name: fmt.Sprintf("%s (%s)", name, rr.Result),
+						Path: path,
+						Type: "XCCDF",
+					},
+				},
+			})
+		}
+	}
+	return results, errs
+}
+
+// shortID strips the prefix of SCAP Security Guide rule ids, e.g.
+// "xccdf_org.ssgproject.content_rule_accounts_tmout" is "accounts_tmout".
+func shortID(id string) string {
+	if i := strings.Index(id, "_rule_"); i >= 0 {
+		return id[i+len("_rule_"):]
+	}
+	return id
+}
@@ -0,0 +1,139 @@
+package xccdf
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/opencontrol/compliance-masonry/pkg/lib/common"
+
+	"github.com/carlosmmatos/automate-compliance/internal/assessment"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+const results = `<?xml version="1.0" encoding="UTF-8"?>
+<Benchmark xmlns="http://checklists.nist.gov/xccdf/1.2" id="xccdf_org.ssgproject.content_benchmark_RHEL-8">
+  <Group id="xccdf_org.ssgproject.content_group_accounts">
+    <Rule id="xccdf_org.ssgproject.content_rule_accounts_tmout">
+      <title>Set Interactive Session Timeout</title>
+      <reference href="http://nvlpubs.nist.gov/nistpubs/SpecialPublications/NIST.SP.800-53r4.pdf">AC-12,AC-2(5)</reference>
+      <reference href="https://www.cisecurity.org/benchmark/red_hat_linux/">5.5.5</reference>
+    </Rule>
+  </Group>
+  <Rule id="xccdf_org.ssgproject.content_rule_audit_login_events">
+    <title>Record login events</title>
+    <reference href="http://nvlpubs.nist.gov/nistpubs/SpecialPublications/NIST.SP.800-53r4.pdf">AU-2(d),AC-2(5),Req-1</reference>
+  </Rule>
+  <Rule id="xccdf_org.ssgproject.content_rule_not_checked">
+    <reference href="http://nvlpubs.nist.gov/nistpubs/SpecialPublications/NIST.SP.800-53r4.pdf">CM-6</reference>
+  </Rule>
+  <TestResult id="xccdf_org.open-scap_testresult_default-profile">
+    <rule-result idref="xccdf_org.ssgproject.content_rule_accounts_tmout"><result>pass</result></rule-result>
+    <rule-result idref="xccdf_org.ssgproject.content_rule_audit_login_events"><result>fail</result></rule-result>
+    <rule-result idref="xccdf_org.ssgproject.content_rule_not_checked"><result>notchecked</result></rule-result>
+  </TestResult>
+</Benchmark>`
+
+const arf = `<?xml version="1.0" encoding="UTF-8"?>
+<arf:asset-report-collection xmlns:arf="http://scap.nist.gov/schema/asset-reporting-format/1.1">
+  <arf:report-requests><arf:report-request id="collection1"><arf:content>
+    <ds:data-stream-collection xmlns:ds="http://scap.nist.gov/schema/scap/source/1.2"><ds:component id="c">
+      <xccdf-1.2:Benchmark xmlns:xccdf-1.2="http://checklists.nist.gov/xccdf/1.2" id="b">
+        <xccdf-1.2:Rule id="xccdf_org.ssgproject.content_rule_sshd_set_idle_timeout">
+          <xccdf-1.2:title>Set SSH Idle Timeout Interval</xccdf-1.2:title>
+          <xccdf-1.2:reference href="http://nvlpubs.nist.gov/nistpubs/SpecialPublications/NIST.SP.800-53r4.pdf">SC-10</xccdf-1.2:reference>
+        </xccdf-1.2:Rule>
+      </xccdf-1.2:Benchmark>
+    </ds:component></ds:data-stream-collection>
+  </arf:content></arf:report-request></arf:report-requests>
+  <arf:reports><arf:report id="xccdf1"><arf:content>
+    <TestResult xmlns="http://checklists.nist.gov/xccdf/1.2" id="t">
+      <rule-result idref="xccdf_org.ssgproject.content_rule_sshd_set_idle_timeout"><result>notapplicable</result></rule-result>
+    </TestResult>
+  </arf:content></arf:report></arf:reports>
+</arf:asset-report-collection>`
+
+func ref(key, name, path string) common.VerificationReference {
+	return common.VerificationReference{Key: key, GeneralReference: common.GeneralReference{Name: name, Path: path, Type: "XCCDF"}}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name         string
+		doc          string
+		want         []assessment.Result
+		wantWarnings int
+	}{
+		{
+			"XCCDF results",
+			results,
+			[]assessment.Result{
+				{ControlKey: "AC-12", Status: parser.StatusComplete, Verification: ref("xccdf-accounts_tmout", "Set Interactive Session Timeout (pass)", "scan.xml")},
+				{ControlKey: "AC-2 (5)", Status: parser.StatusComplete, Verification: ref("xccdf-accounts_tmout", "Set Interactive Session Timeout (pass)", "scan.xml")},
+				{ControlKey: "AU-2", Status: parser.StatusNone, Verification: ref("xccdf-audit_login_events", "Record login events (fail)", "scan.xml")},
+				{ControlKey: "AC-2 (5)", Status: parser.StatusNone, Verification: ref("xccdf-audit_login_events", "Record login events (fail)", "scan.xml")},
+			},
+			1,
+		},
+		{
+			"ARF report",
+			arf,
+			[]assessment.Result{
+				{ControlKey: "SC-10", Status: parser.StatusNotApplicable, Verification: ref("xccdf-sshd_set_idle_timeout", "Set SSH Idle Timeout Interval (notapplicable)", "scan.xml")},
+			},
+			0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := Parse(strings.NewReader(tt.doc))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, warnings := report.ControlResults("scan.xml")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControlResults() = %+v, want %+v", got, tt.want)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("got warnings %v, want %d", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestParse_NoBenchmark(t *testing.T) {
+	report, err := Parse(strings.NewReader(`<TestResult><rule-result idref="r"><result>pass</result></rule-result></TestResult>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, warnings := report.ControlResults("scan.xml"); len(warnings) != 1 {
+		t.Errorf("got warnings %v, want one about the missing benchmark", warnings)
+	}
+	if _, err := Parse(strings.NewReader(`<Benchmark><Rule>`)); err == nil {
+		t.Error("expected an error for truncated XML")
+	}
+}
+
+func TestControlKeys(t *testing.T) {
+	tests := []struct {
+		reference string
+		want      []string
+		wantErrs  int
+	}{
+		{"AC-2(a)", []string{"AC-2"}, 0},
+		{"AC-2(1), IA-5(1)(c)", []string{"AC-2 (1)", "IA-5 (1)"}, 0},
+		{"AC-6 (9),CM-6(a),", []string{"AC-6 (9)", "CM-6"}, 0},
+		{"Req-1,AC-17", []string{"AC-17"}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			got, errs := ControlKeys(tt.reference)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControlKeys() = %v, want %v", got, tt.want)
+			}
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ControlKeys() errors = %v, want %d", errs, tt.wantErrs)
+			}
+		})
+	}
+}
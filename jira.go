@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/carlosmmatos/automate-compliance/internal/baseline"
+	"github.com/carlosmmatos/automate-compliance/internal/catalog"
+	"github.com/carlosmmatos/automate-compliance/internal/jira"
+	"github.com/carlosmmatos/automate-compliance/internal/report"
+)
+
+func runJira(args []string) error {
+	fs := flag.NewFlagSet("jira", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	baseURL := fs.String("url", "", "URL of the Jira server, e.g. https://example.atlassian.net")
+	user := fs.String("user", "", "user of the API token, empty to send the token as a personal access token")
+	tokenEnv := fs.String("token-env", "JIRA_TOKEN", "environment variable holding the API token")
+	project := fs.String("project", "", "key of the project the issues are created in")
+	issueType := fs.String("issue-type", "Task", "type of the issues created")
+	label := fs.String("label", "autocmp", "label of the issues managed by the tool")
+	closeTransition := fs.String("close-transition", "", "name of the transition closing the issues (default: the first transition to a done status)")
+	baselineName := fs.String("baseline", "", "also track the controls of a baseline missing from the sheet: "+strings.Join(baseline.Names(), ", "))
+	catalogPath := fs.String("catalog", "", "OSCAL JSON catalog giving the text of the controls, e.g. the NIST SP 800-53 Rev. 5 catalog")
+	dryRun := fs.Bool("dry-run", false, "print the changes instead of making them")
+	fs.Parse(args)
+
+	if *baseURL == "" || *project == "" {
+		return fmt.Errorf("-url and -project are required")
+	}
+	opts := jira.Options{Project: *project, IssueType: *issueType, Label: *label, CloseTransition: *closeTransition}
+	client := &jira.Client{BaseURL: *baseURL, User: *user, Token: os.Getenv(*tokenEnv)}
+
+	cat := catalog.Default()
+	if *catalogPath != "" {
+		var err error
+		if cat, err = catalog.Load(*catalogPath); err != nil {
+			return err
+		}
+	}
+	// issues are closed from the sheet, so it must parse completely
+	res, err := src.loadResult()
+	if err == nil {
+		err = res.Err()
+	}
+	if err != nil {
+		return err
+	}
+	var missing []string
+	if *baselineName != "" {
+		b, err := baseline.Get(*baselineName)
+		if err != nil {
+			return err
+		}
+		missing = report.NewGaps(b, res.Data).Missing
+	}
+
+	issues, err := client.OpenIssues(opts)
+	if err != nil {
+		return err
+	}
+	actions := jira.Plan(jira.NewControls(res.Data, res.Details, missing, cat), issues, opts)
+	if *dryRun {
+		for _, a := range actions {
+			fmt.Println(a)
+		}
+		fmt.Printf("%d changes, none made (dry run)\n", len(actions))
+		return nil
+	}
+	if err := client.Apply(actions, opts, os.Stdout); err != nil {
+		return err
+	}
+	fmt.Printf("%d changes\n", len(actions))
+	return nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/carlosmmatos/automate-compliance/internal/lint"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+)
+
+func runLint(args []string) error {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	config := fs.String("config", "", "YAML file configuring the rules, see the README")
+	disable := fs.String("disable", "", "comma separated rules to disable: "+strings.Join(lint.Rules(), ", "))
+	product := fs.String("product", "", "name of the product, overrides the config")
+	others := fs.String("other-products", "", "comma separated product names the narratives shouldn't mention, overrides the config")
+	minLength := fs.Int("min-length", 0, "number of characters under which a narrative is too short, overrides the config")
+	maxDuplicates := fs.Int("max-duplicates", 0, "number of controls a narrative can be shared by, overrides the config")
+	format := fs.String("format", "text", "output format: text or json")
+	strict := fs.Bool("strict", false, "fail on warnings too")
+	fs.Parse(args)
+
+	cfg := lint.DefaultConfig()
+	if *config != "" {
+		var err error
+		if cfg, err = lint.LoadConfig(*config); err != nil {
+			return err
+		}
+	}
+	if *disable != "" {
+		for _, rule := range strings.Split(*disable, ",") {
+			if err := cfg.Disable(strings.TrimSpace(rule)); err != nil {
+				return err
+			}
+		}
+	}
+	if *product != "" {
+		cfg.Product = *product
+	}
+	if *others != "" {
+		cfg.OtherProducts = nil
+		for _, name := range strings.Split(*others, ",") {
+			cfg.OtherProducts = append(cfg.OtherProducts, strings.TrimSpace(name))
+		}
+	}
+	if *minLength > 0 {
+		cfg.MinLength = *minLength
+	}
+	if *maxDuplicates > 0 {
+		cfg.MaxDuplicates = *maxDuplicates
+	}
+
+	rows, err := src.readRows()
+	if err != nil {
+		return err
+	}
+	findings := lint.Lint(rows, cfg)
+	switch *format {
+	case "text":
+		err = lint.WriteText(os.Stdout, findings)
+	case "json":
+		err = lint.WriteJSON(os.Stdout, findings)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	if err != nil {
+		return err
+	}
+
+	failed := lint.Count(findings, parser.SeverityError)
+	if *strict {
+		failed = len(findings)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d findings", failed)
+	}
+	return nil
+}
@@ -2,8 +2,14 @@ package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
+	"github.com/carlosmmatos/automate-compliance/internal/history"
+	"github.com/carlosmmatos/automate-compliance/internal/notify"
+	"github.com/carlosmmatos/automate-compliance/internal/opencontrol"
 	"github.com/carlosmmatos/automate-compliance/internal/parser"
+	"github.com/carlosmmatos/automate-compliance/internal/source"
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
 	"golang.org/x/net/context"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
@@ -13,6 +19,10 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 )
 
 // Retrieve a token, saves the token, then returns the generated client.
@@ -74,32 +84,99 @@ func normalizeControl(control string) {
 
 }
 
-func main() {
-	b, err := ioutil.ReadFile("credentials.json")
+// Using the following NIST RHACM 800-53 Example sheet:
+// https://docs.google.com/spreadsheets/d/12883Aj3eK3O0mgOesZMVnoVf8UmEPf1kPMyqFP7cp68/edit
+const (
+	defaultSpreadsheetID = "12883Aj3eK3O0mgOesZMVnoVf8UmEPf1kPMyqFP7cp68"
+	defaultReadRange     = "800-53-controls-new!A2:M"
+)
+
+// sheetSource holds the flags shared by all the commands that read the
+// assessment spreadsheet.
+type sheetSource struct {
+	credentials   string
+	spreadsheetID string
+	readRange     string
+	csvFile       string
+	columns       string
+	history       string
+	notify        string
+
+	// revision identifies the content of the rows last loaded
+	revision string
+}
+
+func (s *sheetSource) register(fs *flag.FlagSet) {
+	fs.StringVar(&s.credentials, "credentials", "credentials.json", "Google API client secret file")
+	fs.StringVar(&s.spreadsheetID, "spreadsheet", defaultSpreadsheetID, "ID of the assessment spreadsheet")
+	fs.StringVar(&s.readRange, "range", defaultReadRange, "range of the spreadsheet to read, starting at the first data row")
+	fs.StringVar(&s.csvFile, "csv", "", "read a CSV export of the spreadsheet instead of using the Sheets API")
+	fs.StringVar(&s.columns, "columns", "", "column layout, e.g. family=A,control=B,narrative=C,status=D,origin=E,owner=F,evidence=G,milestone=H,due=I,automatable=J,policy=K")
+}
+
+// registerHistory adds the -history flag to the commands that record runs.
+func (s *sheetSource) registerHistory(fs *flag.FlagSet) {
+	fs.StringVar(&s.history, "history", ".autocmp/history", "directory where a snapshot of each run is kept, empty to disable")
+}
+
+// registerNotify adds the -notify flag to the commands that record runs.
+func (s *sheetSource) registerNotify(fs *flag.FlagSet) {
+	fs.StringVar(&s.notify, "notify", "", "YAML file configuring the notifications sent after the run, see the README")
+}
+
+// label describes where the rows are read from.
+func (s *sheetSource) label() string {
+	if s.csvFile != "" {
+		return s.csvFile
+	}
+	return "sheet:" + s.spreadsheetID
+}
+
+// rows reads the spreadsheet rows, either from a CSV export or from the
+// Google Sheets API.
+func (s *sheetSource) rows() ([]parser.Row, error) {
+	cols, err := source.ParseColumns(s.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.csvFile != "" {
+		f, err := os.Open(s.csvFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return source.ReadCSV(f, cols)
+	}
+
+	values, err := s.fetchValues()
 	if err != nil {
-		log.Fatalf("Unable to read client secret file: %v", err)
+		return nil, err
+	}
+	return source.FromValues(values, cols), nil
+}
+
+func (s *sheetSource) fetchValues() ([][]interface{}, error) {
+	b, err := ioutil.ReadFile(s.credentials)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read client secret file: %v", err)
 	}
 
 	// If modifying these scopes, delete your previously saved token.json.
 	config, err := google.ConfigFromJSON(b, "https://www.googleapis.com/auth/spreadsheets.readonly")
 	if err != nil {
-		log.Fatalf("Unable to parse client secret file to config: %v", err)
+		return nil, fmt.Errorf("unable to parse client secret file to config: %v", err)
 	}
 	client := getClient(config)
 
 	srv, err := sheets.NewService(context.Background(), option.WithHTTPClient(client))
 	if err != nil {
-		log.Fatalf("Unable to retrieve Sheets client: %v", err)
+		return nil, fmt.Errorf("unable to retrieve Sheets client: %v", err)
 	}
 
-	// Accessing the data in the spreadsheet
-	// Using the following NIST RHACM 800-53 Example sheet:
-	// https://docs.google.com/spreadsheets/d/12883Aj3eK3O0mgOesZMVnoVf8UmEPf1kPMyqFP7cp68/edit
-	spreadsheetId := "12883Aj3eK3O0mgOesZMVnoVf8UmEPf1kPMyqFP7cp68"
-	readRange := "800-53-controls-new!A2:M"
-	resp, err := srv.Spreadsheets.Values.Get(spreadsheetId, readRange).ValueRenderOption("FORMATTED_VALUE").Do()
+	resp, err := srv.Spreadsheets.Values.Get(s.spreadsheetID, s.readRange).ValueRenderOption("FORMATTED_VALUE").Do()
 	if err != nil {
-		log.Fatalf("Unable to retrieve data from sheet: %v", err)
+		return nil, fmt.Errorf("unable to retrieve data from sheet: %v", err)
 	}
 
 	// ***NOTE**** // The output of the Values.Get will mess up the length of
@@ -107,28 +184,246 @@ func main() {
 	// column in the spreadsheet that will always be the last column in my
 	// readRange and have a '.' in it so that it's not empty. Unless there is a
 	// better way to ensure a consistent length of the row of values??
-	if len(resp.Values) == 0 {
-		fmt.Println("No data found.")
-	} else {
-		p := parser.NewParser()
-		for _, row := range resp.Values {
-			family := row[0].(string)
-			control := row[1].(string)
-			err := p.ParseEntry(family, control)
-			if err != nil {
-				fmt.Printf("Found error in control %s: %v\n", control, err)
-				os.Exit(1)
-			}
+	return resp.Values, nil
+}
+
+// load reads and parses the spreadsheet, failing on the first row that can't
+// be parsed.
+func (s *sheetSource) load() (parser.Data, error) {
+	rows, err := s.readRows()
+	if err != nil {
+		return nil, err
+	}
+	return source.Parse(rows)
+}
+
+// loadResult reads and parses the spreadsheet, reporting the rows that can't
+// be parsed as diagnostics.
+func (s *sheetSource) loadResult() (source.Result, error) {
+	rows, err := s.readRows()
+	if err != nil {
+		return source.Result{}, err
+	}
+	return source.ParseRows(rows), nil
+}
+
+func (s *sheetSource) readRows() ([]parser.Row, error) {
+	rows, err := s.rows()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no data found")
+	}
+	s.revision = source.Revision(rows)
+	return rows, nil
+}
+
+// record saves a snapshot of a run in the history directory, then notifies
+// what went wrong since the previous run when -notify is given, which needs
+// the history. The notification config is checked before anything is saved.
+// Runs with rows that can't be parsed are recorded too, so that their errors
+// are notified.
+func (s *sheetSource) record(res source.Result) error {
+	var n *notify.Notifier
+	if s.notify != "" {
+		// without the history every run would be notified as the first one
+		if s.history == "" {
+			return fmt.Errorf("-notify requires -history")
+		}
+		cfg, err := notify.LoadConfig(s.notify)
+		if err != nil {
+			return err
 		}
+		n = notify.New(cfg)
+	}
+	if s.history == "" {
+		return nil
+	}
 
-		fmt.Printf("Parsed data\n")
-		fmt.Printf("===========\n\n")
+	now := time.Now()
+	snap := history.NewSnapshot(now, s.label(), s.revision, res.Data)
+	snap.Errors = res.Errors()
+	store := history.NewStore(s.history)
+	var prev *history.Snapshot
+	if n != nil {
+		var err error
+		if prev, err = store.Last(s.label()); err != nil {
+			return err
+		}
+	}
+	if _, err := store.Save(snap); err != nil {
+		return err
+	}
+	if n == nil {
+		return nil
+	}
+	return n.Send(notify.NewDigest(s.label(), now, res, prev, n.Grace()))
+}
+
+// component loads the controls described by a source spec, which is either
+// an OpenControl component (*.yaml or *.yml) or a sheet spec (see withSpec).
+func (s *sheetSource) component(spec string) (*v3c.Component, error) {
+	c, _, err := s.componentOwners(spec)
+	return c, err
+}
+
+// componentOwners loads the controls described by a source spec along with
+// their owners, see component.
+func (s *sheetSource) componentOwners(spec string) (*v3c.Component, opencontrol.Owners, error) {
+	if ext := strings.ToLower(filepath.Ext(spec)); ext == ".yaml" || ext == ".yml" {
+		c, err := opencontrol.LoadComponent(spec)
+		if err != nil {
+			return nil, nil, err
+		}
+		owners, err := opencontrol.LoadOwners(spec)
+		return c, owners, err
+	}
+	other, err := s.withSpec(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	return other.loadComponentOwners()
+}
+
+// withSpec returns a copy of the source reading from a sheet spec, which is
+// one of:
+//   - "sheet" for the spreadsheet given by the source flags
+//   - "sheet:<spreadsheet id>" for another spreadsheet with the same layout
+//   - a CSV export of a spreadsheet (*.csv)
+func (s *sheetSource) withSpec(spec string) (*sheetSource, error) {
+	other := *s
+	switch {
+	case strings.ToLower(filepath.Ext(spec)) == ".csv":
+		other.csvFile = spec
+	case spec == "sheet":
+		other.csvFile = ""
+	case strings.HasPrefix(spec, "sheet:"):
+		other.csvFile = ""
+		other.spreadsheetID = strings.TrimPrefix(spec, "sheet:")
+	default:
+		return nil, fmt.Errorf("unknown source %q", spec)
+	}
+	return &other, nil
+}
+
+func (s *sheetSource) loadComponent() (*v3c.Component, error) {
+	c, _, err := s.loadComponentOwners()
+	return c, err
+}
+
+// loadComponentOwners reads the spreadsheet as a component, whose
+// responsible role is the owner of the most controls, along with the owners
+// of the controls.
+func (s *sheetSource) loadComponentOwners() (*v3c.Component, opencontrol.Owners, error) {
+	res, err := s.loadResult()
+	if err == nil {
+		err = res.Err()
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	c := opencontrol.NewComponent("", "", "", res.Data)
+	owners := opencontrol.NewOwners(res.Details)
+	c.ResponsibleRole = owners.ResponsibleRole()
+	return c, owners, nil
+}
+
+type command struct {
+	summary string
+	run     func(args []string) error
+}
+
+var commands = map[string]command{
+	"checklist": {"write a STIG Viewer checklist pre-filled from the narratives", runChecklist},
+	"dashboard": {"write a self-contained HTML dashboard", runDashboard},
+	"diff":      {"compare two assessments", runDiff},
+	"docs":      {"write a Markdown/GitBook tree documenting the controls", runDocs},
+	"import":    {"apply the results of an assessment to a component", runImport},
+	"jira":      {"create, update and close the Jira issues tracking the open controls", runJira},
+	"lint":      {"check the narratives for placeholders, TODOs and copy-paste", runLint},
+	"oscal":     {"export the controls as OSCAL documents", runOSCAL},
+	"policies":  {"write a RHACM PolicyGenerator configuration for the automatable controls", runPolicies},
+	"print":     {"parse the spreadsheet and print the result", runPrint},
+	"report":    {"report metrics about the assessment", runReport},
+	"serve":     {"periodically read the spreadsheet and serve the results", runServe},
+	"trend":     {"report the completion of the assessment over time", runTrend},
+	"update":    {"create or update a component.yaml from the spreadsheet", runUpdate},
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: autocmp <command> [flags]\n\nCommands:\n")
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun 'autocmp <command> -h' for the flags of a command.\n")
+}
+
+func runPrint(args []string) error {
+	fs := flag.NewFlagSet("print", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	src.registerHistory(fs)
+	src.registerNotify(fs)
+	fs.Parse(args)
+
+	res, err := src.loadResult()
+	if err != nil {
+		return err
+	}
+	if err := src.record(res); err != nil {
+		return err
+	}
+	if err := res.Err(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Parsed data\n")
+	fmt.Printf("===========\n\n")
 
-		for family, controls := range p.GetData() {
-			fmt.Printf("> %s\n", family)
-			for _, ctrl := range controls {
-				fmt.Printf("- %v\n", ctrl)
-			}
+	for family, controls := range res.Data {
+		fmt.Printf("> %s\n", family)
+		for _, ctrl := range controls {
+			fmt.Printf("- %v\n", ctrl)
 		}
 	}
+	return nil
+}
+
+func main() {
+	// Running without a command keeps the original behaviour of printing the
+	// parsed spreadsheet.
+	name, args := "print", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+	if err := cmd.run(args); err != nil {
+		log.Printf("%s: %v", name, err)
+		if e, ok := err.(exitError); ok {
+			os.Exit(e.code)
+		}
+		os.Exit(1)
+	}
+}
+
+// exitError is returned by the commands exiting with a status of their own,
+// which tells a failed check from a failed run.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e exitError) Error() string {
+	return e.err.Error()
 }
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/oscal"
+	"github.com/carlosmmatos/automate-compliance/internal/source"
+)
+
+var oscalDocuments = map[string]command{
+	"component-definition": {"component-definition describing how the products implement the controls", runComponentDefinition},
+	"poam":                 {"plan-of-action-and-milestones for the controls that aren't complete", runPOAM},
+	"ssp":                  {"system-security-plan of a system made of the products", runSSP},
+}
+
+func runOSCAL(args []string) error {
+	if len(args) == 0 {
+		oscalUsage()
+		os.Exit(2)
+	}
+	d, ok := oscalDocuments[args[0]]
+	if !ok {
+		oscalUsage()
+		os.Exit(2)
+	}
+	return d.run(args[1:])
+}
+
+func oscalUsage() {
+	fmt.Fprintf(os.Stderr, "Usage: autocmp oscal <document> [flags]\n\nDocuments:\n")
+	var names []string
+	for name := range oscalDocuments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-22s %s\n", name, oscalDocuments[name].summary)
+	}
+}
+
+// componentFlags collects the products a document is built from: either the
+// sheet given by the source flags, or the repeated -product flags.
+type componentFlags struct {
+	src      sheetSource
+	name     string
+	products productFlags
+}
+
+// register adds the flags of the commands taking components, whose products
+// may also be component.yaml files.
+func (c *componentFlags) register(fs *flag.FlagSet) {
+	c.registerSources(fs, "\"sheet\", \"sheet:<id>\", a CSV file or a component.yaml")
+}
+
+// registerSheets adds the flags of the commands needing the rows of the
+// sheets, see results.
+func (c *componentFlags) registerSheets(fs *flag.FlagSet) {
+	c.registerSources(fs, "\"sheet\", \"sheet:<id>\" or a CSV file")
+}
+
+func (c *componentFlags) registerSources(fs *flag.FlagSet, sources string) {
+	c.src.register(fs)
+	c.products = make(productFlags)
+	fs.StringVar(&c.name, "name", "Component", "name of the product when no -product is given")
+	fs.Var(c.products, "product", "include a product as name=source, where source is "+sources+"; may be repeated")
+}
+
+// names returns the names of the -product flags, sorted.
+func (c *componentFlags) names() []string {
+	var names []string
+	for name := range c.products {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// load returns the components sorted by name.
+func (c *componentFlags) load() ([]*v3c.Component, error) {
+	if len(c.products) == 0 {
+		comp, err := c.src.loadComponent()
+		if err != nil {
+			return nil, err
+		}
+		comp.Name = c.name
+		return []*v3c.Component{comp}, nil
+	}
+
+	var comps []*v3c.Component
+	for _, name := range c.names() {
+		comp, err := c.src.component(c.products[name])
+		if err != nil {
+			return nil, fmt.Errorf("product %s: %v", name, err)
+		}
+		comp.Name = name
+		comps = append(comps, comp)
+	}
+	return comps, nil
+}
+
+// productResult is the parsed sheet of a product.
+type productResult struct {
+	name string
+	res  source.Result
+}
+
+// results reads and parses the sheets of the products sorted by name. Every
+// row must parse.
+func (c *componentFlags) results() ([]productResult, error) {
+	load := func(name string, src *sheetSource) (productResult, error) {
+		res, err := src.loadResult()
+		if err == nil {
+			err = res.Err()
+		}
+		if err != nil {
+			return productResult{}, fmt.Errorf("product %s: %v", name, err)
+		}
+		return productResult{name: name, res: res}, nil
+	}
+	if len(c.products) == 0 {
+		r, err := load(c.name, &c.src)
+		if err != nil {
+			return nil, err
+		}
+		return []productResult{r}, nil
+	}
+
+	var results []productResult
+	for _, name := range c.names() {
+		src, err := c.src.withSpec(c.products[name])
+		if err != nil {
+			return nil, fmt.Errorf("product %s: %v", name, err)
+		}
+		r, err := load(name, src)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// createOutput opens the file a document is written to, "-" being stdout.
+func createOutput(path string) (io.WriteCloser, error) {
+	if path == "-" {
+		return nopCloser{os.Stdout}, nil
+	}
+	return os.Create(path)
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
+func runComponentDefinition(args []string) error {
+	fs := flag.NewFlagSet("oscal component-definition", flag.ExitOnError)
+	var comps componentFlags
+	comps.register(fs)
+	out := fs.String("o", "-", "file to write the JSON document to, - for stdout")
+	title := fs.String("title", "Component definition", "title of the document")
+	catalog := fs.String("catalog", oscal.DefaultCatalog, "catalog the controls are taken from")
+	fs.Parse(args)
+
+	components, err := comps.load()
+	if err != nil {
+		return err
+	}
+
+	w, err := createOutput(*out)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	if err := oscal.NewComponentDefinition(*title, *catalog, components, time.Now()).WriteJSON(w); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+func runSSP(args []string) error {
+	fs := flag.NewFlagSet("oscal ssp", flag.ExitOnError)
+	var comps componentFlags
+	comps.register(fs)
+	out := fs.String("o", "-", "file to write the JSON document to, - for stdout")
+	system := fs.String("system", "system.yaml", "YAML file describing the system: name, categorization, authorization boundary, roles and parties")
+	fs.Parse(args)
+
+	cfg, err := oscal.LoadSystemConfig(*system)
+	if err != nil {
+		return err
+	}
+	components, err := comps.load()
+	if err != nil {
+		return err
+	}
+
+	w, err := createOutput(*out)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	if err := oscal.NewSSP(cfg, components, time.Now()).WriteJSON(w); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+func runPOAM(args []string) error {
+	fs := flag.NewFlagSet("oscal poam", flag.ExitOnError)
+	var comps componentFlags
+	comps.registerSheets(fs)
+	out := fs.String("o", "-", "file to write the JSON document to, - for stdout")
+	title := fs.String("title", "Plan of action and milestones", "title of the document")
+	systemID := fs.String("system-id", "", "identifier of the system the plan is for")
+	fs.Parse(args)
+
+	results, err := comps.results()
+	if err != nil {
+		return err
+	}
+	var prods []oscal.Product
+	for _, r := range results {
+		prods = append(prods, oscal.Product{Name: r.name, Data: r.res.Data, Details: r.res.Details})
+	}
+
+	poam := oscal.NewPOAM(*title, *systemID, prods, time.Now())
+	w, err := createOutput(*out)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	if err := poam.WriteJSON(w); err != nil {
+		return err
+	}
+	return w.Close()
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/carlosmmatos/automate-compliance/internal/rhacm"
+)
+
+func runPolicies(args []string) error {
+	fs := flag.NewFlagSet("policies", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	out := fs.String("o", "policies", "directory to write the PolicyGenerator configuration to")
+	name := fs.String("name", "compliance", "name of the PolicyGenerator")
+	namespace := fs.String("namespace", "policies", "namespace of the policies on the hub")
+	remediation := fs.String("remediation", "inform", "remediation action of the policies: inform or enforce")
+	severity := fs.String("severity", "low", "severity of the policies")
+	templates := fs.String("templates", ".", "directory the policy templates of the sheet are relative to")
+	selector := fs.String("cluster-selector", "", "labels of the clusters to place the policies on, e.g. vendor=OpenShift,env=prod")
+	fs.Parse(args)
+
+	if *remediation != "inform" && *remediation != "enforce" {
+		return fmt.Errorf("unknown remediation action %q", *remediation)
+	}
+	defaults := rhacm.GeneratorPolicy{Namespace: *namespace, RemediationAction: *remediation, Severity: *severity}
+	if *selector != "" {
+		labels, err := parseLabels(*selector)
+		if err != nil {
+			return err
+		}
+		defaults.Placement = &rhacm.Placement{ClusterSelectors: labels}
+	}
+
+	res, err := src.loadResult()
+	if err == nil {
+		err = res.Err()
+	}
+	if err != nil {
+		return err
+	}
+
+	g, skipped := rhacm.NewGenerator(*name, defaults, res.Data, res.Details)
+	for _, key := range skipped {
+		fmt.Fprintf(os.Stderr, "Warning: %s is automatable but has no policy template\n", key)
+	}
+	if len(g.Policies) == 0 {
+		return fmt.Errorf("no automatable control with a policy template, see the automatable and policy columns")
+	}
+	if err := g.Write(*out, *templates); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote %d policies to %s\n", len(g.Policies), *out)
+	return nil
+}
+
+// parseLabels parses labels such as "vendor=OpenShift,env=prod".
+func parseLabels(spec string) (map[string]string, error) {
+	labels := make(map[string]string)
+	for _, entry := range strings.Split(spec, ",") {
+		kv := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("malformed label %q, expected name=value", entry)
+		}
+		labels[kv[0]] = kv[1]
+	}
+	return labels, nil
+}
@@ -0,0 +1,355 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/carlosmmatos/automate-compliance/internal/assessment"
+	"github.com/carlosmmatos/automate-compliance/internal/baseline"
+	"github.com/carlosmmatos/automate-compliance/internal/catalog"
+	"github.com/carlosmmatos/automate-compliance/internal/content"
+	"github.com/carlosmmatos/automate-compliance/internal/history"
+	"github.com/carlosmmatos/automate-compliance/internal/parser"
+	"github.com/carlosmmatos/automate-compliance/internal/report"
+	"github.com/carlosmmatos/automate-compliance/internal/rhacm"
+)
+
+var reports = map[string]command{
+	"automation":   {"ComplianceAsCode rules automating each control, and the controls without any", runAutomationReport},
+	"coverage":     {"controls, narratives and statuses addressed per family", runCoverageReport},
+	"disagreement": {"controls whose status in the sheet differs from scan or assessment results", runDisagreementReport},
+	"gaps":         {"controls missing from or not required by a baseline", runGapsReport},
+	"overdue":      {"controls that aren't complete past their due date, fails when there are any", runOverdueReport},
+	"owners":       {"controls, open items, stale narratives and completion of each owner", runOwnersReport},
+	"parts":        {"statement parts of each control missing, extra or answered at the wrong granularity", runPartsReport},
+	"policies":     {"RHACM policies enforcing each control and the compliance of the clusters", runPoliciesReport},
+}
+
+func runReport(args []string) error {
+	if len(args) == 0 {
+		reportUsage()
+		os.Exit(2)
+	}
+	r, ok := reports[args[0]]
+	if !ok {
+		reportUsage()
+		os.Exit(2)
+	}
+	return r.run(args[1:])
+}
+
+func reportUsage() {
+	fmt.Fprintf(os.Stderr, "Usage: autocmp report <report> [flags]\n\nReports:\n")
+	var names []string
+	for name := range reports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-14s %s\n", name, reports[name].summary)
+	}
+}
+
+func runCoverageReport(args []string) error {
+	fs := flag.NewFlagSet("report coverage", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	format := fs.String("format", "table", "output format: table, json or markdown")
+	fs.Parse(args)
+
+	data, err := src.load()
+	if err != nil {
+		return err
+	}
+
+	coverage := report.NewCoverage(data)
+	switch *format {
+	case "table":
+		return coverage.WriteTable(os.Stdout)
+	case "json":
+		return coverage.WriteJSON(os.Stdout)
+	case "markdown":
+		return coverage.WriteMarkdown(os.Stdout)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+func runGapsReport(args []string) error {
+	fs := flag.NewFlagSet("report gaps", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	name := fs.String("baseline", "nist-moderate", "baseline to compare against: "+strings.Join(baseline.Names(), ", "))
+	format := fs.String("format", "text", "output format: text or json")
+	fs.Parse(args)
+
+	b, err := baseline.Get(*name)
+	if err != nil {
+		return err
+	}
+	data, err := src.load()
+	if err != nil {
+		return err
+	}
+
+	gaps := report.NewGaps(b, data)
+	switch *format {
+	case "text":
+		return gaps.WriteText(os.Stdout)
+	case "json":
+		return gaps.WriteJSON(os.Stdout)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+// exitOverdue is the exit status of the overdue report when there are overdue
+// controls.
+const exitOverdue = 3
+
+func runOverdueReport(args []string) error {
+	fs := flag.NewFlagSet("report overdue", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	days := fs.Int("grace", 0, "number of days a control may stay open past its due date")
+	byStatus := fs.String("grace-status", "", "grace periods of some statuses, e.g. partial=30,planned=7,unset=0")
+	on := fs.String("on", "", "date to check the due dates against, e.g. 2021-09-30 (default: today)")
+	format := fs.String("format", "text", "output format: text or json")
+	fs.Parse(args)
+
+	grace, err := parseGrace(*days, *byStatus)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if *on != "" {
+		if now, err = parser.ParseDate(*on); err != nil {
+			return err
+		}
+	}
+	res, err := src.loadResult()
+	if err == nil {
+		err = res.Err()
+	}
+	if err != nil {
+		return err
+	}
+
+	overdue := report.NewOverdue(res.Data, res.Details, now, grace)
+	switch *format {
+	case "text":
+		err = overdue.WriteText(os.Stdout)
+	case "json":
+		err = overdue.WriteJSON(os.Stdout)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	if err != nil {
+		return err
+	}
+	if n := len(overdue.Controls); n > 0 {
+		return exitError{exitOverdue, fmt.Errorf("%d controls overdue", n)}
+	}
+	return nil
+}
+
+// parseGrace parses grace periods per status such as "partial=30,unset=0".
+func parseGrace(days int, spec string) (report.Grace, error) {
+	grace := report.Grace{Days: days}
+	if spec == "" {
+		return grace, nil
+	}
+	values, err := parseLabels(spec)
+	if err != nil {
+		return grace, err
+	}
+	grace.ByStatus = make(map[string]int)
+	for status, v := range values {
+		if status != report.StatusUnset {
+			status = parser.NormalizeStatus(status)
+			if !parser.KnownStatus(status) {
+				return grace, fmt.Errorf("unknown status %q in -grace-status", status)
+			}
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return grace, fmt.Errorf("invalid grace period %q of %s", v, status)
+		}
+		grace.ByStatus[status] = n
+	}
+	return grace, nil
+}
+
+func runOwnersReport(args []string) error {
+	fs := flag.NewFlagSet("report owners", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	fs.StringVar(&src.history, "history", ".autocmp/history", "directory holding the snapshots of previous runs, empty to ignore them")
+	staleAfter := fs.Int("stale-after", 180, "number of days after which a narrative that hasn't changed is stale, according to -history")
+	format := fs.String("format", "markdown", "output format: markdown or json")
+	fs.Parse(args)
+
+	res, err := src.loadResult()
+	if err == nil {
+		err = res.Err()
+	}
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	var snaps []history.Snapshot
+	if src.history != "" {
+		if snaps, err = history.NewStore(src.history).LoadSource(src.label()); err != nil {
+			return err
+		}
+	}
+	// the sheet may have changed since the last run
+	snaps = append(snaps, history.NewSnapshot(now, src.label(), src.revision, res.Data))
+
+	owners := report.NewOwners(res.Data, res.Details, history.NarrativeChanged(snaps), now, *staleAfter)
+	switch *format {
+	case "markdown":
+		return owners.WriteMarkdown(os.Stdout)
+	case "json":
+		return owners.WriteJSON(os.Stdout)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+func runPartsReport(args []string) error {
+	fs := flag.NewFlagSet("report parts", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	path := fs.String("catalog", "", "OSCAL JSON catalog giving the parts of the controls, instead of the built-in subset of NIST SP 800-53 Rev. 5")
+	format := fs.String("format", "text", "output format: text or json")
+	fs.Parse(args)
+
+	c := catalog.Default()
+	if *path != "" {
+		var err error
+		if c, err = catalog.Load(*path); err != nil {
+			return err
+		}
+	}
+	data, err := src.load()
+	if err != nil {
+		return err
+	}
+
+	parts := report.NewParts(data, c)
+	switch *format {
+	case "text":
+		return parts.WriteText(os.Stdout)
+	case "json":
+		return parts.WriteJSON(os.Stdout)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+func runDisagreementReport(args []string) error {
+	fs := flag.NewFlagSet("report disagreement", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	from := fs.String("from", "xccdf", "format of the result files: "+strings.Join(resultFormats(), ", "))
+	registerLoaderFlags(fs)
+	format := fs.String("format", "text", "output format: text or json")
+	fs.Parse(args)
+
+	data, err := src.load()
+	if err != nil {
+		return err
+	}
+	results, err := loadResults(*from, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	cmp := assessment.Compare(data, results)
+	switch *format {
+	case "text":
+		return cmp.WriteText(os.Stdout)
+	case "json":
+		return cmp.WriteJSON(os.Stdout)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+func runAutomationReport(args []string) error {
+	fs := flag.NewFlagSet("report automation", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	dir := fs.String("content", "", "path to a checkout of ComplianceAsCode/content")
+	uncovered := fs.Bool("uncovered", false, "only report the controls without automated rules, JSON always has both")
+	format := fs.String("format", "text", "output format: text or json")
+	fs.Parse(args)
+
+	if *dir == "" {
+		return fmt.Errorf("-content is required")
+	}
+	rules, warnings, err := content.Load(*dir)
+	if err != nil {
+		return err
+	}
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", w)
+	}
+	data, err := src.load()
+	if err != nil {
+		return err
+	}
+
+	automation := report.NewAutomation(data, rules)
+	switch {
+	case *format == "json":
+		return automation.WriteJSON(os.Stdout)
+	case *format != "text":
+		return fmt.Errorf("unknown format %q", *format)
+	case *uncovered:
+		return automation.WriteUncovered(os.Stdout)
+	default:
+		return automation.WriteText(os.Stdout)
+	}
+}
+
+func runPoliciesReport(args []string) error {
+	fs := flag.NewFlagSet("report policies", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	format := fs.String("format", "text", "output format: text or json")
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		return fmt.Errorf("expected at least one policy file or directory")
+	}
+
+	set := rhacm.NewSet()
+	for _, path := range fs.Args() {
+		if err := set.Load(path); err != nil {
+			return err
+		}
+	}
+	for _, w := range set.Warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", w)
+	}
+	data, err := src.load()
+	if err != nil {
+		return err
+	}
+
+	policies := report.NewPolicies(data, set)
+	switch *format {
+	case "text":
+		return policies.WriteText(os.Stdout)
+	case "json":
+		return policies.WriteJSON(os.Stdout)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
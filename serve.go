@@ -0,0 +1,202 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/carlosmmatos/automate-compliance/internal/api"
+	"github.com/carlosmmatos/automate-compliance/internal/history"
+	"github.com/carlosmmatos/automate-compliance/internal/metrics"
+	"github.com/carlosmmatos/automate-compliance/internal/notify"
+	"github.com/carlosmmatos/automate-compliance/internal/source"
+)
+
+// productFlags collects repeated -product name=source flags.
+type productFlags map[string]string
+
+func (p productFlags) String() string {
+	var entries []string
+	for name, spec := range p {
+		entries = append(entries, name+"="+spec)
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+func (p productFlags) Set(v string) error {
+	kv := strings.SplitN(v, "=", 2)
+	if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+		return fmt.Errorf("expected name=source, got %q", v)
+	}
+	p[kv[0]] = kv[1]
+	return nil
+}
+
+// server periodically ingests the sheets of every product.
+type server struct {
+	src      *sheetSource
+	products map[string]*sheetSource
+	exporter *metrics.Exporter
+	// notifier is nil when no notification is configured
+	notifier *notify.Notifier
+	// last is the snapshot of the previous sync of every product, when
+	// there is no history to read it from
+	last map[string]*history.Snapshot
+
+	mu sync.Mutex
+	// synced is the last sync of every product, served by the API
+	synced map[string]api.Product
+}
+
+func newServer(src *sheetSource, products productFlags, defaultName string) (*server, error) {
+	s := &server{
+		src:      src,
+		products: make(map[string]*sheetSource),
+		exporter: metrics.NewExporter(),
+		last:     make(map[string]*history.Snapshot),
+		synced:   make(map[string]api.Product),
+	}
+	if len(products) == 0 {
+		s.products[defaultName] = src
+	}
+	for name, spec := range products {
+		p, err := src.withSpec(spec)
+		if err != nil {
+			return nil, fmt.Errorf("product %s: %v", name, err)
+		}
+		s.products[name] = p
+	}
+	for name := range s.products {
+		s.synced[name] = api.Product{Name: name}
+	}
+	return s, nil
+}
+
+// Products returns the last sync of every product, see api.Store.
+func (s *server) Products() []api.Product {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var products []api.Product
+	for _, p := range s.synced {
+		products = append(products, p)
+	}
+	return products
+}
+
+// sync reads and parses the sheet of every product once.
+func (s *server) sync() {
+	for name, p := range s.products {
+		rows, err := p.rows()
+		if err != nil {
+			log.Printf("Unable to sync %s: %v", name, err)
+			s.exporter.Fail(name)
+			s.mu.Lock()
+			p := s.synced[name]
+			p.Err = err
+			s.synced[name] = p
+			s.mu.Unlock()
+			continue
+		}
+		res := source.ParseRows(rows)
+		now := time.Now()
+		s.exporter.Update(name, res.Data, res.Diagnostics, now)
+		s.mu.Lock()
+		s.synced[name] = api.Product{Name: name, Result: &res, Synced: now}
+		s.mu.Unlock()
+		if err := s.record(name, p, res, now); err != nil {
+			log.Printf("Unable to record the sync of %s: %v", name, err)
+		}
+	}
+}
+
+// record saves a snapshot of a sync in the history directory, then notifies
+// what went wrong since the previous snapshot of the product. Without history
+// the previous sync is only kept in memory, so the first sync after starting
+// only sets the baseline the next ones are compared with.
+func (s *server) record(name string, p *sheetSource, res source.Result, now time.Time) error {
+	snap := history.NewSnapshot(now, name, p.revision, res.Data)
+	snap.Errors = res.Errors()
+	var prev *history.Snapshot
+	if s.src.history == "" {
+		last, ok := s.last[name]
+		s.last[name] = &snap
+		if !ok {
+			return nil
+		}
+		prev = last
+	} else {
+		store := history.NewStore(s.src.history)
+		var err error
+		if prev, err = store.Last(name); err != nil {
+			return err
+		}
+		if _, err := store.Save(snap); err != nil {
+			return err
+		}
+	}
+	if s.notifier == nil {
+		return nil
+	}
+	return s.notifier.Send(notify.NewDigest(name, now, res, prev, s.notifier.Grace()))
+}
+
+func (s *server) run(interval time.Duration) {
+	for {
+		s.sync()
+		time.Sleep(interval)
+	}
+}
+
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	src.registerHistory(fs)
+	src.registerNotify(fs)
+	products := make(productFlags)
+	fs.Var(products, "product", "name=source of a product to serve, may be repeated (default: the sheet given by the source flags)")
+	name := fs.String("component", "default", "name of the product read from the source flags when no -product is given")
+	listen := fs.String("listen", ":9090", "address to listen on")
+	interval := fs.Duration("interval", 15*time.Minute, "how often the sheets are read again")
+	withMetrics := fs.Bool("metrics", false, "serve Prometheus metrics on /metrics")
+	withAPI := fs.Bool("api", false, "serve the REST API on "+api.Prefix+", described by "+api.Prefix+"openapi.json")
+	fs.Parse(args)
+
+	if !*withMetrics && !*withAPI && src.notify == "" {
+		return fmt.Errorf("nothing to serve, use -metrics, -api or -notify")
+	}
+
+	srv, err := newServer(&src, products, *name)
+	if err != nil {
+		return err
+	}
+	if src.notify != "" {
+		cfg, err := notify.LoadConfig(src.notify)
+		if err != nil {
+			return err
+		}
+		srv.notifier = notify.New(cfg)
+	}
+	if !*withMetrics && !*withAPI {
+		srv.run(*interval)
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	if *withMetrics {
+		mux.Handle("/metrics", srv.exporter)
+	}
+	if *withAPI {
+		mux.Handle(api.Prefix, api.NewHandler(srv))
+	}
+
+	go srv.run(*interval)
+	log.Printf("Listening on %s", *listen)
+	return http.ListenAndServe(*listen, mux)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/carlosmmatos/automate-compliance/internal/history"
+)
+
+func runTrend(args []string) error {
+	fs := flag.NewFlagSet("trend", flag.ExitOnError)
+	dir := fs.String("history", ".autocmp/history", "directory holding the snapshots of previous runs")
+	source := fs.String("source", "", "source of the snapshots, i.e. the -csv file or sheet:<spreadsheet id> (default: the only source in the history)")
+	format := fs.String("format", "text", "output format: text, csv or json")
+	fs.Parse(args)
+
+	store := history.NewStore(*dir)
+	if *source == "" {
+		sources, err := historySources(store)
+		if err != nil {
+			return err
+		}
+		if len(sources) > 1 {
+			return fmt.Errorf("%s holds the snapshots of several sources, choose one with -source: %s", *dir, strings.Join(sources, ", "))
+		}
+		if len(sources) == 1 {
+			*source = sources[0]
+		}
+	}
+	snaps, err := store.LoadSource(*source)
+	if err != nil {
+		return err
+	}
+	if len(snaps) == 0 && *source != "" {
+		return fmt.Errorf("no snapshots of %s found in %s", *source, *dir)
+	} else if len(snaps) == 0 {
+		return fmt.Errorf("no snapshots found in %s", *dir)
+	}
+
+	trend := history.NewTrend(snaps)
+	switch *format {
+	case "text":
+		fmt.Printf("%d snapshots from %s to %s\n\n", len(snaps),
+			snaps[0].Timestamp.Format("2006-01-02"), snaps[len(snaps)-1].Timestamp.Format("2006-01-02"))
+		return trend.WriteText(os.Stdout)
+	case "csv":
+		return trend.WriteCSV(os.Stdout)
+	case "json":
+		return trend.WriteJSON(os.Stdout)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+// historySources returns the sources of the snapshots of a store.
+func historySources(store *history.Store) ([]string, error) {
+	snaps, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var sources []string
+	for _, snap := range snaps {
+		if !seen[snap.Source] {
+			seen[snap.Source] = true
+			sources = append(sources, snap.Source)
+		}
+	}
+	sort.Strings(sources)
+	return sources, nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	v3c "github.com/opencontrol/compliance-masonry/pkg/lib/components/versions/3_1_0"
+
+	"github.com/carlosmmatos/automate-compliance/internal/opencontrol"
+)
+
+// defaultBasePath returns where the sheet data of the last sync is stored
+// for a component, e.g. .component.base.yaml next to component.yaml.
+func defaultBasePath(component string) string {
+	dir, file := filepath.Split(component)
+	ext := filepath.Ext(file)
+	return filepath.Join(dir, "."+strings.TrimSuffix(file, ext)+".base"+ext)
+}
+
+func runUpdate(args []string) error {
+	fs := flag.NewFlagSet("update", flag.ExitOnError)
+	var src sheetSource
+	src.register(fs)
+	src.registerHistory(fs)
+	src.registerNotify(fs)
+	out := fs.String("o", "component.yaml", "component.yaml to create or update")
+	basePath := fs.String("base", "", "sheet data of the last sync (default: .<name>.base.yaml next to the component)")
+	name := fs.String("name", "", "component name, required when creating the component")
+	key := fs.String("key", "", "component key")
+	standard := fs.String("standard", "NIST-800-53", "standard key of the controls")
+	role := fs.String("role", "", "responsible role of the component (default: the owner of the most controls)")
+	fs.Parse(args)
+
+	if *basePath == "" {
+		*basePath = defaultBasePath(*out)
+	}
+
+	res, err := src.loadResult()
+	if err != nil {
+		return err
+	}
+	if err := src.record(res); err != nil {
+		return err
+	}
+	if err := res.Err(); err != nil {
+		return err
+	}
+	sheet := opencontrol.NewComponent(*name, *key, *standard, res.Data)
+	owners := opencontrol.NewOwners(res.Details)
+	sheet.ResponsibleRole = owners.ResponsibleRole()
+	if *role != "" {
+		sheet.ResponsibleRole = *role
+	}
+
+	local, err := opencontrol.LoadComponent(*out)
+	if os.IsNotExist(err) {
+		if *name == "" {
+			return fmt.Errorf("%s doesn't exist, -name is required to create it", *out)
+		}
+		if err := opencontrol.WriteComponent(*out, sheet, owners); err != nil {
+			return err
+		}
+		fmt.Printf("Created %s with %d controls\n", *out, len(sheet.Satisfies))
+		return opencontrol.WriteComponent(*basePath, sheet, owners)
+	} else if err != nil {
+		return err
+	}
+
+	var base *v3c.Component
+	var baseOwners opencontrol.Owners
+	if _, err := os.Stat(*basePath); err == nil {
+		if base, err = opencontrol.LoadComponent(*basePath); err != nil {
+			return err
+		}
+		if baseOwners, err = opencontrol.LoadOwners(*basePath); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintf(os.Stderr, "No base found at %s, every difference will be reported as a conflict\n", *basePath)
+	}
+
+	localOwners, err := opencontrol.LoadOwners(*out)
+	if err != nil {
+		return err
+	}
+	merged, conflicts := opencontrol.Merge(base, local, sheet)
+	mergedOwners := opencontrol.MergeOwners(baseOwners, localOwners, owners)
+	if reflect.DeepEqual(merged, local) && reflect.DeepEqual(mergedOwners, localOwners) {
+		fmt.Printf("%s is up to date\n", *out)
+	} else {
+		canonical, err := opencontrol.Canonical(*out)
+		if err != nil {
+			return err
+		}
+		if !canonical {
+			fmt.Fprintf(os.Stderr, "%s isn't in the form written by autocmp, its comments and key order are rewritten\n", *out)
+		}
+		if err := opencontrol.WriteComponent(*out, merged, mergedOwners); err != nil {
+			return err
+		}
+		fmt.Printf("Updated %s\n", *out)
+	}
+	// the base of the conflicting controls isn't advanced, so that their
+	// sheet side isn't lost once the component keeps its local value
+	nextBase := opencontrol.NextBase(base, sheet, conflicts)
+	if err := opencontrol.WriteComponent(*basePath, nextBase, opencontrol.NextBaseOwners(baseOwners, owners, conflicts)); err != nil {
+		return err
+	}
+
+	if len(conflicts) > 0 {
+		for _, c := range conflicts {
+			fmt.Fprintf(os.Stderr, "CONFLICT %s\n", c)
+		}
+		return fmt.Errorf("%d conflicts, resolve them in %s", len(conflicts), *out)
+	}
+	return nil
+}